@@ -0,0 +1,120 @@
+// Go 标准库的 `archive/tar` 和 `compress/gzip` 包
+// 可以用来创建和读取常见的 `.tar.gz` 压缩包。
+// 这里我们在内存中创建一个压缩包，再把它读出来。
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// 要打包的文件。
+var files = []struct {
+	name, body string
+}{
+	{"readme.txt", "This archive contains text files."},
+	{"gopher.txt", "Gopher names:\nGeorge\nGonzo"},
+	{"todo.txt", "Get animal handling license."},
+}
+
+// 为了让输出固定，所有文件都使用同一个修改时间。
+var modTime = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// `create` 将文件写入一个 `.tar.gz` 格式的压缩包。
+// 这些 writer 是层层嵌套的：tar 的输出交给 gzip 压缩，
+// gzip 的输出再写入 `w`。
+func create(w io.Writer) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	// gzip 也有自己的头，可以记录被压缩的文件名等信息。
+	// 这些字段必须在第一次写入之前设置。
+	gw.Name = "notes.tar"
+	gw.ModTime = modTime
+
+	for _, file := range files {
+		// 每个文件以一个 `tar.Header` 开头，它记录了文件名、
+		// 权限、大小等元数据。`Size` 必须与随后写入的字节数相同。
+		hdr := &tar.Header{
+			Name:    file.name,
+			Mode:    0600,
+			Size:    int64(len(file.body)),
+			ModTime: modTime,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		// 写入头之后，再写入文件的内容。
+		_, err := io.WriteString(tw, file.body)
+		if err != nil {
+			return err
+		}
+	}
+
+	// 关闭的顺序与创建的顺序相反：先关闭 tar writer，
+	// 它会写出压缩包末尾的结束标记；再关闭 gzip writer，
+	// 它会压缩并写出仍在缓冲中的数据以及校验和。
+	// 如果顺序颠倒，tar 的结束标记就进不了压缩流，
+	// 得到的将是一个不完整的压缩包。
+	// 这里我们也要检查 `Close` 返回的错误。
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// `extract` 以同样嵌套的方式读取压缩包：
+// gzip reader 负责解压，tar reader 从解压后的数据中读取文件。
+func extract(r io.Reader) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+	fmt.Println("gzip name:", gr.Name)
+	tr := tar.NewReader(gr)
+
+	// `Next` 前进到下一个文件，并返回它的头。
+	// 所有文件都读完后，它返回 `io.EOF`。
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s %v %d bytes %s\n", hdr.Name,
+			hdr.FileInfo().Mode(), hdr.Size,
+			hdr.ModTime.UTC().Format(time.DateTime))
+
+		// 在调用下一次 `Next` 之前，`tr` 读出的是当前文件的内容。
+		var body strings.Builder
+		if _, err := io.Copy(&body, tr); err != nil {
+			return err
+		}
+		first, _, _ := strings.Cut(body.String(), "\n")
+		fmt.Printf("  %q\n", first)
+	}
+	return nil
+}
+
+func main() {
+	var buf bytes.Buffer
+	if err := create(&buf); err != nil {
+		panic(err)
+	}
+
+	// 压缩包的内容以 gzip 的魔数 `1f 8b` 开头。
+	fmt.Printf("magic: % x\n", buf.Bytes()[:2])
+
+	if err := extract(&buf); err != nil {
+		panic(err)
+	}
+}
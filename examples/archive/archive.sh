@@ -0,0 +1,11 @@
+# 运行程序，它会列出压缩包中每个文件的元数据，
+# 以及文件内容的第一行。
+$ go run archive.go
+magic: 1f 8b
+gzip name: notes.tar
+readme.txt -rw------- 33 bytes 2024-01-02 03:04:05
+  "This archive contains text files."
+gopher.txt -rw------- 26 bytes 2024-01-02 03:04:05
+  "Gopher names:"
+todo.txt -rw------- 28 bytes 2024-01-02 03:04:05
+  "Get animal handling license."
@@ -10,10 +10,17 @@ import (
 	"sync/atomic"
 )
 
+type config struct {
+	name    string
+	version int
+}
+
 func main() {
 
-	// 我们将使用一个无符号整型（永远是正整数）变量来表示这个计数器。
-	var ops uint64
+	// 从 Go 1.19 开始，`sync/atomic` 提供了 `atomic.Int64`
+	// 这样的类型。它们的零值就可以直接使用，
+	// 并且只能通过原子方法访问，避免了意外的非原子读写。
+	var ops atomic.Int64
 
 	// WaitGroup 帮助我们等待所有协程完成它们的工作。
 	var wg sync.WaitGroup
@@ -23,19 +30,82 @@ func main() {
 		wg.Add(1)
 
 		go func() {
+			defer wg.Done()
 			for c := 0; c < 1000; c++ {
-				// 使用 `AddUint64` 来让计数器自动增加，
-				// 使用 `&` 语法给定 `ops` 的内存地址。
-				atomic.AddUint64(&ops, 1)
+				// 使用 `Add` 来原子地增加计数器。
+				ops.Add(1)
 			}
-			wg.Done()
 		}()
 	}
 
-	// 等待，直到所有协程完成。
+	// 等待，直到所有协程完成，然后用 `Load` 读取计数器的值。
+	// 即使仍有协程在写入，`Load` 也能安全地读取它。
+	wg.Wait()
+	fmt.Println("ops:", ops.Load())
+
+	// `CompareAndSwap` 只在当前值等于预期的旧值时才写入新值。
+	// 把它放在循环里，可以实现任意的“读取-修改-写入”操作，
+	// 这里用它记录所有协程见过的最大值。
+	var highest atomic.Int64
+	for i := 1; i <= 10; i++ {
+		wg.Add(1)
+		go func(v int64) {
+			defer wg.Done()
+			for {
+				old := highest.Load()
+				if v <= old ||
+					highest.CompareAndSwap(old, v) {
+					return
+				}
+			}
+		}(int64(i * 7))
+	}
+	wg.Wait()
+	fmt.Println("highest:", highest.Load())
+
+	// `atomic.Bool` 可以用作一次性的标志：
+	// 只有第一个将它从 `false` 改为 `true` 的协程会执行工作。
+	var started atomic.Bool
+	var winners atomic.Int64
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if started.CompareAndSwap(false, true) {
+				winners.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	fmt.Println("started:", started.Load(),
+		"winners:", winners.Load())
+
+	// `atomic.Pointer[T]` 原子地保存一个指向 `T` 的指针。
+	// 写入者通过 `Store` 整体替换配置，读取者通过 `Load`
+	// 拿到的总是某一个完整的配置，而不会看到写了一半的数据。
+	var cfg atomic.Pointer[config]
+	cfg.Store(&config{name: "default", version: 1})
+	for i := 2; i <= 4; i++ {
+		wg.Add(1)
+		go func(v int) {
+			defer wg.Done()
+			for {
+				old := cfg.Load()
+				if old.version >= v {
+					return
+				}
+				next := &config{"reloaded", v}
+				if cfg.CompareAndSwap(old, next) {
+					return
+				}
+			}
+		}(i)
+	}
 	wg.Wait()
+	c := cfg.Load()
+	fmt.Println("config:", c.name, c.version)
 
-	// 现在可以安全的访问 `ops`，因为我们知道，此时没有协程写入 `ops`，
-	// 此外，还可以使用 `atomic.LoadUint64` 之类的函数，在原子更新的同时安全地读取它们。
-	fmt.Println("ops:", ops)
+	// 与[互斥锁](mutexes)相比，原子操作没有加锁和解锁的开销，
+	// 但只适用于单个值的简单更新；
+	// 一旦需要同时更新多个相关的值，互斥锁通常更简单、更不容易出错。
 }
@@ -3,5 +3,8 @@
 # 此外，运行程序时带上 `-race` 标志，我们可以获取数据竞争失败的详情。
 $ go run atomic-counters.go
 ops: 50000
+highest: 70
+started: true winners: 1
+config: reloaded 4
 
 # 接下来，我们看一下管理状态的另一个工具——互斥锁。
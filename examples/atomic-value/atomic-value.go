@@ -0,0 +1,114 @@
+// 在[原子计数器](atomic-counters)的例子中，我们简单地提到了
+// `atomic.Pointer`。这里我们来看看它在实际中的典型用法：
+// 配置的热更新。后台协程不断地替换配置，
+// 而大量的读取者无需加锁就能读到当前的配置。
+
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// `config` 一旦创建就不再修改。每次更新都会创建一个新的 `config`，
+// 再原子地替换指针，所以读取者拿到的配置永远不会改变。
+// `addr` 由 `version` 推导出来，我们借此检查读到的配置是否完整。
+type config struct {
+	version int
+	addr    string
+	timeout int
+}
+
+func newConfig(version int) *config {
+	return &config{
+		version: version,
+		addr:    fmt.Sprintf("db%d:5432", version),
+		timeout: version * 100,
+	}
+}
+
+// `consistent` 报告配置的各个字段是否属于同一个版本。
+func (c *config) consistent() bool {
+	addr := fmt.Sprintf("db%d:5432", c.version)
+	return c.addr == addr && c.timeout == c.version*100
+}
+
+const (
+	versions = 10
+	readers  = 4
+)
+
+func main() {
+
+	// `atomic.Pointer[config]` 的零值保存的是 `nil`，
+	// 所以在启动读取者之前先存入初始配置。
+	var current atomic.Pointer[config]
+	current.Store(newConfig(1))
+
+	// `pending` 用来协调写入者和读取者：每发布一个新版本，
+	// 写入者都会等待所有读取者看到它，再发布下一个版本。
+	// 这只是为了让例子的输出固定，真实的程序中读取者
+	// 可能会错过某些版本，但读到的仍然总是某个完整的版本。
+	var pending sync.WaitGroup
+	pending.Add(readers)
+
+	// 读取者只调用 `Load`，不需要任何锁。
+	// 它们记录见过的不同配置的数量，以及不完整的配置的数量。
+	seen := make([]int, readers)
+	torn := make([]int, readers)
+	var done sync.WaitGroup
+	for r := range readers {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			last := 0
+			for last < versions {
+				c := current.Load()
+				if c.version == last {
+					runtime.Gosched()
+					continue
+				}
+				if !c.consistent() {
+					torn[r]++
+				}
+				seen[r]++
+				last = c.version
+				pending.Done()
+			}
+		}()
+	}
+
+	// 写入者在“后台”依次发布新的配置。
+	// 如果使用一个由互斥锁保护、原地修改的结构体，读取者每次都要加锁，
+	// 而一旦忘记加锁，就可能读到新旧字段混合在一起的配置。
+	go func() {
+		for v := 2; v <= versions; v++ {
+			pending.Wait()
+			pending.Add(readers)
+			current.Store(newConfig(v))
+		}
+	}()
+	done.Wait()
+
+	for r := range readers {
+		fmt.Printf("reader %d: %d configs, %d torn\n",
+			r, seen[r], torn[r])
+	}
+	c := current.Load()
+	fmt.Println("final:", c.version, c.addr, c.timeout)
+
+	// 在泛型出现之前，通常使用 `atomic.Value` 来实现同样的功能。
+	// 它可以保存任意类型的值，但 `Load` 返回的是 `any`，
+	// 需要进行类型断言。而且一个 `atomic.Value` 中
+	// 存入的所有值必须具有相同的具体类型，否则 `Store` 会 panic：
+	// `sync/atomic: store of inconsistently typed value into Value`。
+	var v atomic.Value
+	v.Store(*newConfig(1))
+	fmt.Println("value:", v.Load().(config).addr)
+	defer func() {
+		fmt.Println("panicked:", recover() != nil)
+	}()
+	v.Store("db2:5432")
+}
@@ -0,0 +1,10 @@
+# 每个读取者都看到了全部 10 个版本的配置，
+# 并且没有读到任何不完整的配置。
+$ go run atomic-value.go
+reader 0: 10 configs, 0 torn
+reader 1: 10 configs, 0 torn
+reader 2: 10 configs, 0 torn
+reader 3: 10 configs, 0 torn
+final: 10 db10:5432 1000
+value: db1:5432
+panicked: true
@@ -5,8 +5,11 @@ package main
 // 这个语法引入了 `encoding/base64` 包，
 // 并使用别名 `b64` 代替默认的 `base64`。这样可以节省点空间。
 import (
+	"bytes"
 	b64 "encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"io"
 )
 
 func main() {
@@ -31,4 +34,65 @@ func main() {
 	fmt.Println(uEnc)
 	uDec, _ := b64.URLEncoding.DecodeString(uEnc)
 	fmt.Println(string(uDec))
+	fmt.Println()
+
+	// 标准编码使用 `+` 和 `/`，它们在 URL 中有特殊的含义，
+	// `/` 也不能出现在文件名中。所以当编码结果要放进查询参数、
+	// URL 路径或者文件名时，应该使用 URL 兼容的编码，
+	// 它用 `-` 和 `_` 代替了这两个字符；其他场景使用标准编码即可。
+	//
+	// 每 3 个字节编码为 4 个字符，当输入的长度不是 3 的倍数时，
+	// 结果的末尾会用 `=` 补齐，而 `RawStdEncoding`
+	// 和 `RawURLEncoding` 则省略这些填充字符。
+	// 例如 JWT 就使用不带填充的 URL 兼容编码。
+	short := []byte("go!?")
+	fmt.Println(b64.StdEncoding.EncodeToString(short))
+	fmt.Println(b64.RawURLEncoding.EncodeToString(short))
+
+	// 解码时，编码方式必须与编码时一致：
+	// 带填充的解码器不接受缺少填充的输入，反之亦然。
+	// 格式错误的输入会返回一个 `CorruptInputError`，
+	// 它的值是第一个非法字节的位置。
+	_, err := b64.StdEncoding.DecodeString("Z28hPw")
+	fmt.Println(err)
+	_, err = b64.StdEncoding.DecodeString("Z28h*w==")
+	fmt.Println(err)
+
+	// 对于较大的数据，`base64.NewEncoder` 返回一个 `io.Writer`，
+	// 写入它的数据被编码后写入底层的 writer。
+	// 最后必须调用 `Close`，把剩余不足 3 个字节的数据连同填充写出。
+	var buf bytes.Buffer
+	enc := b64.NewEncoder(b64.StdEncoding, &buf)
+	enc.Write([]byte("streaming "))
+	enc.Write([]byte("base64"))
+	enc.Close()
+	fmt.Println(buf.String())
+
+	// `base64.NewDecoder` 则返回一个边读取边解码的 `io.Reader`。
+	dec := b64.NewDecoder(b64.StdEncoding, &buf)
+	out, err := io.ReadAll(dec)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(out))
+	fmt.Println()
+
+	// `encoding/hex` 将每个字节编码为两个 16 进制字符，
+	// 结果比 base64 更长，但更容易阅读。
+	hEnc := hex.EncodeToString([]byte("hex!"))
+	fmt.Println(hEnc)
+	hDec, err := hex.DecodeString(hEnc)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(hDec))
+
+	// 奇数长度或者非 16 进制的字符都会导致解码错误。
+	_, err = hex.DecodeString("6g")
+	fmt.Println(err)
+
+	// `hex.Dump` 生成类似 `hexdump -C` 的输出，
+	// 左边是偏移量和 16 进制的字节，右边是可打印的字符，
+	// 非常适合查看二进制数据。
+	fmt.Print(hex.Dump([]byte("gopher\x00\x01\n")))
 }
@@ -7,3 +7,15 @@ abc123!?$*&()'-=@~
 
 YWJjMTIzIT8kKiYoKSctPUB-
 abc123!?$*&()'-=@~
+
+Z28hPw==
+Z28hPw
+illegal base64 data at input byte 4
+illegal base64 data at input byte 4
+c3RyZWFtaW5nIGJhc2U2NA==
+streaming base64
+
+68657821
+hex!
+encoding/hex: invalid byte: U+0067 'g'
+00000000  67 6f 70 68 65 72 00 01  0a  ...  |gopher...|
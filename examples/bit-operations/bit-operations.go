@@ -0,0 +1,97 @@
+// Go 提供了一组位运算符，用来直接操作整数的二进制位；
+// `math/bits` 包则提供了计数、旋转等常用的位操作函数。
+
+package main
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// 位标志常用来表示一个小的选项集合：每个常量占用一个二进制位，
+// 使用 `1 << iota` 可以依次得到 1、2、4、8。
+type perm uint8
+
+const (
+	read perm = 1 << iota
+	write
+	exec
+	admin
+)
+
+func (p perm) has(flag perm) bool {
+	return p&flag != 0
+}
+
+func main() {
+
+	// 我们用 `%04b` 以二进制打印数字，以便观察结果。
+	a, b := uint8(0b1100), uint8(0b1010)
+	fmt.Printf("a      = %04b\n", a)
+	fmt.Printf("b      = %04b\n", b)
+
+	// `&` 是按位与，`|` 是按位或，`^` 是按位异或：
+	// 在对应的位不同时结果为 1。
+	fmt.Printf("a & b  = %04b\n", a&b)
+	fmt.Printf("a | b  = %04b\n", a|b)
+	fmt.Printf("a ^ b  = %04b\n", a^b)
+
+	// `&^` 是 Go 特有的“按位清除”（AND NOT）运算符：
+	// `a &^ b` 等价于 `a & (^b)`，即把 `b` 中为 1 的位
+	// 在 `a` 中清零，其余位保持不变。
+	// 注意它与 `a ^ b` 不同，后者会把 `a` 中为 0 的位置 1。
+	fmt.Printf("a &^ b = %04b\n", a&^b)
+
+	// 作为一元运算符，`^` 表示按位取反。
+	fmt.Printf("^a     = %08b\n", ^a)
+
+	// `<<` 和 `>>` 是移位运算符。对无符号整数，
+	// 左移一位相当于乘以 2，右移一位相当于除以 2；
+	// 移出范围的位会被丢弃。
+	// 注意常量表达式中溢出会导致编译错误，所以这里使用变量。
+	high := uint8(0b10000000)
+	fmt.Println(a<<1, a>>2, high<<1)
+
+	// 对有符号整数，右移是算术移位，会保留符号位。
+	fmt.Println(int8(-8) >> 1)
+
+	// `math/bits` 中的函数都针对无符号整数，
+	// 并且对每种位宽都有对应的版本，例如 `OnesCount8`。
+	// `OnesCount` 计算值为 1 的位的个数，
+	// `LeadingZeros` 和 `TrailingZeros` 计算开头和末尾 0 的个数，
+	// `Len` 返回表示这个数所需的最少位数。
+	x := uint8(0b00101100)
+	fmt.Println("ones:", bits.OnesCount8(x))
+	fmt.Println("leading:", bits.LeadingZeros8(x))
+	fmt.Println("trailing:", bits.TrailingZeros8(x))
+	fmt.Println("len:", bits.Len8(x))
+
+	// `Reverse` 反转所有的位，`RotateLeft` 循环左移：
+	// 与 `<<` 不同，移出的位会从另一端回来。
+	// 传入负数表示循环右移。
+	fmt.Printf("reverse: %08b\n", bits.Reverse8(x))
+	fmt.Printf("rotate: %08b\n", bits.RotateLeft8(x, 3))
+	fmt.Printf("rotate: %08b\n", bits.RotateLeft8(x, -3))
+
+	// 不带位宽后缀的版本作用于 `uint`，
+	// 它的大小与平台有关，所以这里使用 `uint64` 的版本。
+	fmt.Println("len64:", bits.Len64(1<<40))
+
+	// 下面使用前面定义的位标志。
+	// 使用 `|` 组合多个标志，使用 `&` 测试某个标志。
+	p := read | write
+	fmt.Printf("perm: %04b\n", p)
+	fmt.Println("write:", p.has(write))
+	fmt.Println("exec:", p.has(exec))
+
+	// 使用 `|=` 设置标志，使用 `&^=` 清除标志，
+	// 使用 `^=` 切换标志。
+	p |= exec
+	fmt.Printf("set exec: %04b\n", p)
+	p &^= write
+	fmt.Printf("clear write: %04b\n", p)
+	p ^= admin
+	fmt.Printf("toggle admin: %04b\n", p)
+	p ^= admin
+	fmt.Printf("toggle admin: %04b\n", p)
+}
@@ -0,0 +1,25 @@
+$ go run bit-operations.go
+a      = 1100
+b      = 1010
+a & b  = 1000
+a | b  = 1110
+a ^ b  = 0110
+a &^ b = 0100
+^a     = 11110011
+24 3 0
+-4
+ones: 3
+leading: 2
+trailing: 2
+len: 6
+reverse: 00110100
+rotate: 01100001
+rotate: 10000101
+len64: 41
+perm: 0011
+write: true
+exec: false
+set exec: 0111
+clear write: 0101
+toggle admin: 1101
+toggle admin: 0101
@@ -0,0 +1,42 @@
+// Go 1.21 新增了三个内置函数：`min`、`max` 和 `clear`。
+
+package main
+
+import "fmt"
+
+// 在 Go 1.21 之前，我们需要为每种类型编写类似的辅助函数，
+// 或者使用 `math.Min` 这样只接受 `float64` 的函数。
+func intMin(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func main() {
+	fmt.Println("intMin:", intMin(3, 7))
+
+	// 现在，`min` 和 `max` 可以直接使用。
+	// 它们接受一个或多个参数，并返回其中最小或最大的值。
+	fmt.Println("min:", min(3, 7))
+	fmt.Println("max:", max(3, 7, 5))
+
+	// 它们适用于任何 *有序（ordered）* 类型，
+	// 即可以使用 `<` 比较的类型：整数、浮点数以及字符串。
+	// 所有参数的类型必须相同，无类型的常量会被自动转换。
+	x := 2.5
+	fmt.Println("min:", min(x, 1, 4))
+	fmt.Println("max:", max("apple", "banana", "cherry"))
+	fmt.Println("min:", min("b", "ab"))
+
+	// `clear` 作用于 map 时，删除其中所有的条目。
+	m := map[string]int{"a": 1, "b": 2}
+	clear(m)
+	fmt.Println("map:", m, "len:", len(m))
+
+	// `clear` 作用于切片时，并不会改变切片的长度，
+	// 而是将所有元素设置为对应类型的零值。
+	s := []int{1, 2, 3}
+	clear(s)
+	fmt.Println("slice:", s, "len:", len(s))
+}
@@ -0,0 +1,9 @@
+$ go run builtins-min-max.go
+intMin: 3
+min: 3
+max: 7
+min: 1
+max: cherry
+min: ab
+map: map[] len: 0
+slice: [0 0 0] len: 3
@@ -0,0 +1,139 @@
+// 这个例子把[工作池](worker-pools)和 [context](context) 结合起来：
+// 当某个 worker 出错时，其他 worker 会尽快放弃手头的任务，
+// 还没有开始的任务也不会再被分发出去。
+//
+// 取消是 _协作式_ 的：`cancel` 只是关闭了 `ctx.Done()` 通道，
+// 并不会打断正在运行的代码。如果一个长时间运行的循环从不检查
+// context，它就会一直运行到结束，取消也就失去了意义。
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// 每个任务由若干个步骤组成，每一步耗时 `step`。
+// `fail` 为真的任务会在第一步之后出错。
+type item struct {
+	id, steps int
+	fail      bool
+}
+
+const step = 20 * time.Millisecond
+
+// `process` 模拟一项耗时较长的计算。它在每一步开始之前
+// 检查 `ctx.Err()`，一旦 context 被取消就立刻返回，
+// 最多只会多做一步的工作。如果每一步都在等待什么，
+// 也可以在 `select` 中同时等待 `ctx.Done()`。
+func process(ctx context.Context, it item) error {
+	for i := range it.steps {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		time.Sleep(step)
+		if it.fail && i == 0 {
+			return fmt.Errorf("item %d: invalid input",
+				it.id)
+		}
+	}
+	return nil
+}
+
+// `result` 是 worker 报告给主协程的处理结果。
+type result struct {
+	id  int
+	err error
+}
+
+// worker 从 `jobs` 中接收任务，并把每个任务的结果发送出去。
+// 出错之后 worker 就不再接收新的任务了：
+// 无论是任务本身失败，还是因为取消而放弃，
+// 继续处理剩下的任务都没有意义。
+func worker(ctx context.Context, jobs <-chan item,
+	results chan<- result) {
+	for it := range jobs {
+		err := process(ctx, it)
+		results <- result{it.id, err}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func main() {
+
+	// 前 6 个任务很快就能完成，第 7 个任务会出错，
+	// 第 8、9 个任务需要很长时间，其余的任务还在排队。
+	var items []item
+	for id := 1; id <= 12; id++ {
+		it := item{id: id, steps: 2}
+		switch {
+		case id == 7:
+			it.fail = true
+		case id > 7:
+			it.steps = 10
+		}
+		items = append(items, it)
+	}
+
+	// `WithCancelCause` 创建的 context 在取消时可以记录原因，
+	// 之后通过 `context.Cause` 取得。
+	ctx, cancel := context.WithCancelCause(
+		context.Background())
+	defer cancel(nil)
+
+	// 分发任务的协程在每次发送时同时等待 `ctx.Done()`，
+	// 这样取消之后，剩下的任务就不会再被发送出去。
+	jobs := make(chan item)
+	go func() {
+		defer close(jobs)
+		for _, it := range items {
+			select {
+			case jobs <- it:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	results := make(chan result)
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Go(func() { worker(ctx, jobs, results) })
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// 主协程充当监督者：收到第一个错误时就取消 context。
+	// [errgroup](errgroup) 的 `WithContext` 封装了同样的模式。
+	// 因取消而返回的任务，其错误满足 `context.Canceled`，
+	// 它们被视为“放弃”，而不是失败。
+	var completed, abandoned []int
+	received := 0
+	for r := range results {
+		received++
+		switch {
+		case r.err == nil:
+			completed = append(completed, r.id)
+		case errors.Is(r.err, context.Canceled):
+			abandoned = append(abandoned, r.id)
+		default:
+			cancel(r.err)
+		}
+	}
+
+	// 任务完成的顺序是不确定的，因此先排序再打印。
+	slices.Sort(completed)
+	slices.Sort(abandoned)
+	fmt.Println("first error:", context.Cause(ctx))
+	fmt.Println("completed:", completed)
+	fmt.Println("abandoned:", abandoned)
+	fmt.Println("not started:", len(items)-received)
+}
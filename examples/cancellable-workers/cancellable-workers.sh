@@ -0,0 +1,7 @@
+# 前 6 个任务顺利完成，第 7 个任务出错之后，
+# 正在处理的两个任务被放弃，剩下的 3 个任务没有开始。
+$ go run cancellable-workers.go
+first error: item 7: invalid input
+completed: [1 2 3 4 5 6]
+abandoned: [8 9]
+not started: 3
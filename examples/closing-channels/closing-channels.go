@@ -1,3 +1,5 @@
+// norun
+
 // _关闭_ 一个通道意味着不能再向这个通道发送值了。
 // 该特性可以向通道的接收方传达工作已经完成的信息。
 
@@ -7,7 +7,6 @@ package main
 import "fmt"
 
 // `intSeq` 函数返回一个在其函数体内定义的匿名函数。
-// 返回的函数使用闭包的方式 _隐藏_ 变量 `i`。
 // 返回的函数 _隐藏_ 变量 `i` 以形成闭包。
 func intSeq() func() int {
 	i := 0
@@ -17,6 +16,24 @@ func intSeq() func() int {
 	}
 }
 
+// 闭包也常用来实现记忆化（memoization）：
+// `memoize` 返回的函数把计算过的结果保存在捕获的 map 中，
+// 相同的参数再次出现时直接返回缓存的结果。
+// `calls` 同样被闭包捕获，记录 `f` 实际被调用的次数。
+func memoize(f func(int) int) (func(int) int, *int) {
+	cache := map[int]int{}
+	calls := 0
+	return func(n int) int {
+		if v, ok := cache[n]; ok {
+			return v
+		}
+		calls++
+		v := f(n)
+		cache[n] = v
+		return v
+	}, &calls
+}
+
 func main() {
 
 	// 我们调用 `intSeq` 函数，将返回值（一个函数）赋给 `nextInt`。
@@ -31,4 +48,36 @@ func main() {
 	// 为了确认这个状态对于这个特定的函数是唯一的，我们重新创建并测试一下。
 	newInts := intSeq()
 	fmt.Println(newInts())
+
+	// 闭包捕获的是变量本身，而不是变量在某一时刻的值。
+	// 在循环中创建闭包时，这一点尤为重要。
+	// 从 Go 1.22 开始，`for` 循环的每次迭代都有自己的循环变量 `i`，
+	// 所以每个闭包捕获的是不同的变量，下面依次打印 0、1、2。
+	// 而在 Go 1.22 之前，整个循环共享同一个变量 `i`，
+	// 循环结束后它的值是 3，所以三个闭包都会打印 3。
+	// 在旧的代码中，你可能会看到在循环体内写 `i := i`
+	// 来为每次迭代创建一个新的变量，这正是为了规避这个问题。
+	// 新的语义取决于 `go.mod` 中声明的 Go 版本，而不是编译器的版本。
+	var funcs []func() int
+	for i := 0; i < 3; i++ {
+		funcs = append(funcs, func() int { return i })
+	}
+	for _, f := range funcs {
+		fmt.Println(f())
+	}
+
+	// 使用 `memoize` 包装一个递归计算斐波那契数的函数。
+	// 匿名函数无法直接引用自身，所以我们先声明变量 `fib`，
+	// 再将闭包赋值给它，闭包中通过捕获的 `fib` 进行递归调用。
+	var fib func(int) int
+	fib, calls := memoize(func(n int) int {
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	})
+	fmt.Println(fib(40), *calls)
+
+	// 再次调用时，结果直接来自缓存，`calls` 不再增加。
+	fmt.Println(fib(30), *calls)
 }
@@ -3,5 +3,10 @@ $ go run closures.go
 2
 3
 1
+0
+1
+2
+102334155 41
+832040 41
 
 # 我们马上要学习关于函数的最后一个特性：递归。
@@ -1,3 +1,5 @@
+// norun
+
 // [_命令行参数_](http://en.wikipedia.org/wiki/Command-line_interface#Arguments)
 // 是指定程序运行参数的一个常见方式。例如，`go run hello.go`，
 // 程序 `go` 使用了 `run` 和 `hello.go` 两个参数。
@@ -9,27 +9,67 @@ package main
 import (
 	"flag"
 	"fmt"
+	"os"
+	"strings"
 )
 
-func main() {
+// 除了内置的类型，任何实现了 `flag.Value` 接口的类型
+// 都可以作为标志的值。`listFlag` 将逗号分隔的字符串
+// 解析为一个列表，并且可以多次指定，每次都会追加到列表中。
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(s string) error {
+	*l = append(*l, strings.Split(s, ",")...)
+	return nil
+}
+
+// `parse` 解析一组命令行参数，并打印结果。
+func parse(args []string) error {
+
+	// `flag.String` 这些函数在默认的 `flag.CommandLine`
+	// 上声明标志，`flag.Parse` 则解析 `os.Args[1:]`。
+	// 为了能用固定的参数演示，这里创建了一个独立的 `FlagSet`，
+	// 它提供了同样的方法。`ContinueOnError` 表示解析出错时
+	// 返回错误，而不是直接退出程序。
+	fs := flag.NewFlagSet("flags", flag.ContinueOnError)
+	fs.SetOutput(os.Stdout)
 
 	// 基本的标记声明仅支持字符串、整数和布尔值选项。
 	// 这里我们声明一个默认值为 `"foo"` 的字符串标志 `word` 并带有一个简短的描述。
-	// 这里的 `flag.String` 函数返回一个字符串指针（不是一个字符串值），
+	// 这里的 `String` 方法返回一个字符串指针（不是一个字符串值），
 	// 在下面我们会看到是如何使用这个指针的。
-	wordPtr := flag.String("word", "foo", "a string")
+	wordPtr := fs.String("word", "foo", "a string")
 
 	// 使用和声明 `word` 标志相同的方法来声明 `numb` 和 `fork` 标志。
-	numbPtr := flag.Int("numb", 42, "an int")
-	forkPtr := flag.Bool("fork", false, "a bool")
+	numbPtr := fs.Int("numb", 42, "an int")
+	forkPtr := fs.Bool("fork", false, "a bool")
 
 	// 用程序中已有的参数来声明一个标志也是可以的。
 	// 注意在标志声明函数中需要使用该参数的指针。
 	var svar string
-	flag.StringVar(&svar, "svar", "bar", "a string var")
+	fs.StringVar(&svar, "svar", "bar", "a string var")
+
+	// `Var` 声明一个使用自定义 `flag.Value` 的标志。
+	var tags listFlag
+	fs.Var(&tags, "tags", "comma-separated tags")
+
+	// 遇到 `-h` 或者解析错误时，`FlagSet` 会调用 `Usage`
+	// 打印帮助信息。可以替换它来自定义帮助信息的格式，
+	// `PrintDefaults` 会列出所有标志及其默认值。
+	fs.Usage = func() {
+		out := fs.Output()
+		fmt.Fprintln(out, "usage: flags [options] [args]")
+		fs.PrintDefaults()
+	}
 
-	// 所有标志都声明完成以后，调用 `flag.Parse()` 来执行命令行解析。
-	flag.Parse()
+	// 所有标志都声明完成以后，调用 `Parse` 来执行命令行解析。
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
 
 	// 这里我们将仅输出解析的选项以及后面的位置参数。
 	// 注意，我们需要使用类似 `*wordPtr` 这样的语法来对指针解引用，
@@ -38,5 +78,76 @@ func main() {
 	fmt.Println("numb:", *numbPtr)
 	fmt.Println("fork:", *forkPtr)
 	fmt.Println("svar:", svar)
-	fmt.Println("tail:", flag.Args())
+	fmt.Println("tags:", tags)
+	fmt.Println("tail:", fs.Args())
+	return nil
+}
+
+// 一些命令行工具，例如 `go` 或者 `git`，有很多 *子命令*，
+// 每个子命令都有自己的一组标志。可以为每个子命令创建一个
+// `FlagSet`，再根据第一个参数（通常是 `os.Args[1]`）
+// 决定使用哪一个。
+func subcommand(args []string) {
+	fooCmd := flag.NewFlagSet("foo", flag.ExitOnError)
+	fooEnable := fooCmd.Bool("enable", false, "enable")
+	fooName := fooCmd.String("name", "", "name")
+
+	barCmd := flag.NewFlagSet("bar", flag.ExitOnError)
+	barLevel := barCmd.Int("level", 0, "level")
+
+	switch args[0] {
+	case "foo":
+		fooCmd.Parse(args[1:])
+		fmt.Println("subcommand 'foo'")
+		fmt.Println("  enable:", *fooEnable)
+		fmt.Println("  name:", *fooName)
+		fmt.Println("  tail:", fooCmd.Args())
+	case "bar":
+		barCmd.Parse(args[1:])
+		fmt.Println("subcommand 'bar'")
+		fmt.Println("  level:", *barLevel)
+		fmt.Println("  tail:", barCmd.Args())
+	default:
+		fmt.Println("expected 'foo' or 'bar' subcommands")
+	}
+}
+
+func main() {
+
+	// 真正的程序会调用 `parse(os.Args[1:])`，
+	// 这里依次使用几组固定的参数，模拟多次运行程序。
+	runs := [][]string{
+		// 首先给所有标志赋值。
+		{"-word=opt", "-numb=7", "-fork", "-svar=flag"},
+		// 如果省略一个标志，那么这个标志的值自动的设定为他的默认值。
+		// 尾随的位置参数可以出现在任何标志后面。
+		{"-word=opt", "a1", "a2", "a3"},
+		// 注意，`flag` 包需要所有的标志出现位置参数之前
+		//（否则，这个标志将会被解析为位置参数）。
+		{"-word=opt", "a1", "-numb=7"},
+		// 自定义的标志可以指定多次。
+		{"-tags=a,b", "-tags", "c"},
+		// `-h` 或 `-help` 打印帮助信息，并返回 `flag.ErrHelp`。
+		{"-h"},
+		// 使用未定义的标志会打印错误信息和帮助信息。
+		{"-wat"},
+	}
+	for _, args := range runs {
+		fmt.Println("$ flags", strings.Join(args, " "))
+		if err := parse(args); err != nil {
+			fmt.Println("error:", err)
+		}
+		fmt.Println()
+	}
+
+	// 子命令的标志跟在子命令的名称之后。
+	subRuns := [][]string{
+		{"foo", "-enable", "-name=joe", "a1"},
+		{"bar", "-level", "8", "a1"},
+		{"baz"},
+	}
+	for _, args := range subRuns {
+		fmt.Println("$ flags", strings.Join(args, " "))
+		subcommand(args)
+	}
 }
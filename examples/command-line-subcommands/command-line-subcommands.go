@@ -1,3 +1,5 @@
+// norun
+
 // `go` 和 `git` 这种命令行工具，都有很多的 *子命令* 。
 // 并且每个工具都有一套自己的 flag，比如：
 // `go build` 和 `go get` 是 `go` 里面的两个不同的子命令。
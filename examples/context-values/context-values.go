@@ -0,0 +1,92 @@
+// 除了取消信号和截止时间，[context](context) 还可以携带
+// 请求范围的值，例如请求 ID 或者已认证的用户，
+// 让它们随着 context 沿调用链一路向下传递。
+//
+// 注意：context 的值只应该用于在进程和 API 之间传递的、
+// 请求范围的数据。不要用它来传递函数的可选参数：
+// 这样的依赖对调用者是不可见的，也无法被编译器检查，
+// 应该将它们作为显式的参数传递。
+
+package main
+
+import (
+	"context"
+	"fmt"
+)
+
+// context 中的值以键来查找，任何可比较的类型都可以作为键。
+// 为了避免与其他包使用的键发生冲突，应该定义一个未导出的键类型。
+// 即使另一个包同样使用 `0` 作为键，由于类型不同，它们也不会相等。
+// 而如果使用 `"requestID"` 这样的字符串作为键，
+// 任何包都可能恰好使用同一个字符串，从而悄悄地覆盖或读到别人的值。
+type ctxKey int
+
+const (
+	requestIDKey ctxKey = iota
+	userKey
+)
+
+// 通常为每个值提供一对辅助函数，而不是直接暴露键。
+// `context.WithValue` 返回一个新的 context，它包装了父 context，
+// 原来的 context 不会被修改。
+func withRequestID(ctx context.Context,
+	id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// `ctx.Value` 返回的是 `any`，所以需要进行类型断言。
+// 使用两个返回值的形式，这样在值不存在时不会 panic。
+func requestID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey).(string)
+	return id, ok
+}
+
+// `handler` 是一个简单的处理函数的类型，
+// 我们用它来演示中间件风格的写法。
+type handler func(ctx context.Context, path string)
+
+// `withIDs` 是一个中间件：它为每个请求分配一个 ID，
+// 存入 context 后再调用下一个处理函数。
+func withIDs(next handler) handler {
+	n := 0
+	return func(ctx context.Context, path string) {
+		n++
+		id := fmt.Sprintf("req-%03d", n)
+		next(withRequestID(ctx, id), path)
+	}
+}
+
+// 下游的函数无需改变参数，就能从 context 中读到请求 ID，
+// 例如用于日志记录。
+func logf(ctx context.Context, format string,
+	args ...any) {
+	id, ok := requestID(ctx)
+	if !ok {
+		id = "-"
+	}
+	msg := fmt.Sprintf(format, args...)
+	fmt.Printf("[%s] %s\n", id, msg)
+}
+
+func serve(ctx context.Context, path string) {
+	logf(ctx, "handling %s", path)
+	if user, ok := ctx.Value(userKey).(string); ok {
+		logf(ctx, "user %s", user)
+	}
+}
+
+func main() {
+	h := withIDs(serve)
+
+	// 每次请求都会分配一个新的 ID。
+	ctx := context.Background()
+	h(ctx, "/")
+	h(ctx, "/about")
+
+	// 查找时会从内向外依次检查每一层 context，
+	// 所以外层设置的值在内层同样可见。
+	h(context.WithValue(ctx, userKey, "gopher"), "/me")
+
+	// 没有经过中间件的调用读不到请求 ID。
+	logf(ctx, "no request")
+}
@@ -0,0 +1,8 @@
+# 经过中间件的每个请求都带有自己的 ID，
+# 而直接调用 `logf` 时读不到任何 ID。
+$ go run context-values.go
+[req-001] handling /
+[req-002] handling /about
+[req-003] handling /me
+[req-003] user gopher
+[-] no request
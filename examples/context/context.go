@@ -1,44 +1,87 @@
-// 在前面的示例中，我们研究了配置简单的 [HTTP 服务器](http-servers)。
-// HTTP 服务器对于演示 `context.Context` 的用法很有用的，
-// `context.Context` 被用于控制 cancel。
-// `Context` 跨 API 边界和协程携带了：deadline、取消信号以及其他请求范围的值。
+// `context.Context` 跨 API 边界和协程携带了：deadline、取消信号以及其他请求范围的值。
+// 在 [HTTP 服务器](http-servers) 中，每个请求都带有一个 context；
+// 这里我们直接创建 context，演示如何使用它取消协程中的工作，
+// 以及如何为工作设置超时时间。
 
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"net/http"
 	"time"
 )
 
-func hello(w http.ResponseWriter, req *http.Request) {
-
-	// `net/http` 机制为每个请求创建了一个 `context.Context`，
-	// 并且可以通过 `Context()` 方法获取并使用它。
-	ctx := req.Context()
-	fmt.Println("server: hello handler started")
-	defer fmt.Println("server: hello handler ended")
-
-	// 等待几秒钟，然后再将回复发送给客户端。
-	// 这可以模拟服务器正在执行的某些工作。
-	// 在工作时，请密切关注 context 的 `Done()` 通道的信号，
-	// 一旦收到该信号，表明我们应该取消工作并尽快返回。
+// `work` 模拟一个耗时 `d` 的操作。
+// 在工作时，它会密切关注 context 的 `Done()` 通道，
+// 一旦该通道被关闭，就表明我们应该放弃工作并尽快返回。
+// context 的 `Err()` 方法返回一个错误，该错误说明了 `Done` 通道关闭的原因。
+func work(ctx context.Context, d time.Duration) error {
 	select {
-	case <-time.After(10 * time.Second):
-		fmt.Fprintf(w, "hello\n")
+	case <-time.After(d):
+		return nil
 	case <-ctx.Done():
-		// context 的 `Err()` 方法返回一个错误，
-		// 该错误说明了 `Done` 通道关闭的原因。
-		err := ctx.Err()
-		fmt.Println("server:", err)
-		internalError := http.StatusInternalServerError
-		http.Error(w, err.Error(), internalError)
+		return ctx.Err()
+	}
+}
+
+// context 通常作为函数的第一个参数，沿着调用链一路向下传递。
+// 这样，调用者的取消信号和超时设置会同时作用于整条调用链。
+func fetch(ctx context.Context, d time.Duration) error {
+	if err := work(ctx, d); err != nil {
+		return fmt.Errorf("fetch: %w", err)
+	}
+	return nil
+}
+
+// `report` 通过 `errors.Is` 区分两种错误：
+// `context.Canceled` 表示 context 被主动取消，
+// `context.DeadlineExceeded` 则表示 context 超时了。
+func report(name string, err error) {
+	switch {
+	case err == nil:
+		fmt.Println(name+":", "finished")
+	case errors.Is(err, context.Canceled):
+		fmt.Println(name+":", "canceled:", err)
+	case errors.Is(err, context.DeadlineExceeded):
+		fmt.Println(name+":", "timed out:", err)
 	}
 }
 
 func main() {
 
-	// 跟前面一样，我们在 `/hello` 路由上注册 handler，然后开始提供服务。
-	http.HandleFunc("/hello", hello)
-	http.ListenAndServe(":8090", nil)
+	// `context.WithCancel` 返回一个新的 context，以及用于取消它的 `cancel` 函数。
+	// 我们在一个协程中启动工作，稍后调用 `cancel`，
+	// 协程会从 `ctx.Done()` 收到信号并提前返回。
+	ctx, cancel := context.WithCancel(
+		context.Background())
+	done := make(chan error)
+	go func() {
+		done <- fetch(ctx, time.Second)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	report("cancel", <-done)
+
+	// `context.WithTimeout` 会在给定的时间之后自动取消 context。
+	// 即使工作提前完成，也要调用返回的 `cancel` 函数，
+	// 以尽快释放与 context 关联的资源，通常使用 `defer` 来做这件事。
+	ctx, cancel = context.WithTimeout(
+		context.Background(), 50*time.Millisecond)
+	defer cancel()
+	report("timeout", fetch(ctx, time.Second))
+
+	// `context.WithDeadline` 与 `WithTimeout` 类似，
+	// 只是它接受的是一个绝对的时间点，而不是一段时长。
+	deadline := time.Now().Add(50 * time.Millisecond)
+	ctx, cancel = context.WithDeadline(
+		context.Background(), deadline)
+	defer cancel()
+	report("deadline", fetch(ctx, time.Second))
+
+	// 如果工作在超时之前完成，就不会返回错误。
+	ctx, cancel = context.WithTimeout(
+		context.Background(), time.Second)
+	defer cancel()
+	report("quick", fetch(ctx, 10*time.Millisecond))
 }
@@ -1,10 +1,7 @@
-# 后台运行服务器。
-$ go run context-in-http-servers.go &
-
-# 模拟客户端发出 `/hello` 请求，
-# 在服务端开始处理后，按下 Ctrl+C 以发出取消信号。
-$ curl localhost:8090/hello
-server: hello handler started
-^C
-server: context canceled
-server: hello handler ended
+# 运行程序，可以看到被取消的工作和超时的工作
+# 分别返回了不同的错误，而按时完成的工作则没有返回错误。
+$ go run context.go
+cancel: canceled: fetch: context canceled
+timeout: timed out: fetch: context deadline exceeded
+deadline: timed out: fetch: context deadline exceeded
+quick: finished
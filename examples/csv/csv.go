@@ -0,0 +1,99 @@
+// CSV（逗号分隔值）是一种常见的表格数据格式。
+// Go 通过 `encoding/csv` 包提供了对 CSV 读写的内建支持，
+// 它实现的是 [RFC 4180](https://www.rfc-editor.org/rfc/rfc4180)
+// 中描述的格式。
+
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+func main() {
+
+	// `csv.NewWriter` 将记录写入任意的 `io.Writer`，
+	// 这里使用一个内存中的缓冲区。每条记录是一个 `[]string`。
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	records := [][]string{
+		{"name", "city", "quote"},
+		{"Alice", "Paris", "hello, world"},
+		{"Bob", "Berlin", `say "hi"`},
+	}
+	for _, record := range records {
+		if err := w.Write(record); err != nil {
+			panic(err)
+		}
+	}
+
+	// `Writer` 带有缓冲，写完之后需要调用 `Flush`，
+	// 把剩余的数据写入底层的 writer。
+	// `Flush` 本身不返回错误，写入或刷新过程中
+	// 发生的错误需要通过 `Error` 获取。
+	w.Flush()
+	if err := w.Error(); err != nil {
+		panic(err)
+	}
+
+	// 注意引号的规则：包含逗号、引号或换行的字段
+	// 会被放在双引号中，字段内的双引号则写成两个双引号。
+	fmt.Print(buf.String())
+
+	// `csv.NewReader` 从任意的 `io.Reader` 读取记录。
+	// 反复调用 `Read`，每次返回一条记录，
+	// 读完所有数据之后返回 `io.EOF`。
+	r := csv.NewReader(&buf)
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("%d fields: %q\n", len(record), record)
+	}
+
+	// 读取器可以进行一些配置。`Comma` 设置字段的分隔符，
+	// 例如很多欧洲地区导出的 CSV 使用分号分隔。
+	// `FieldsPerRecord` 为正数时，要求每条记录都有这么多字段；
+	// 为 0（默认值）时，以第一条记录的字段数为准。
+	// `ReadAll` 一次读取所有剩余的记录。
+	const semi = "id;price\n1;9,99\n2;0,50\n"
+	r = csv.NewReader(strings.NewReader(semi))
+	r.Comma = ';'
+	r.FieldsPerRecord = 2
+	rows, err := r.ReadAll()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(rows)
+
+	// 严格遵守 RFC 4180 时，未加引号的字段中不能出现双引号。
+	// 很多程序导出的数据并不规范，
+	// 设置 `LazyQuotes` 可以宽松地接受这样的引号。
+	const lazy = `a,5" screen,b` + "\n"
+	r = csv.NewReader(strings.NewReader(lazy))
+	r.LazyQuotes = true
+	rows, err = r.ReadAll()
+	fmt.Printf("%q %v\n", rows, err)
+
+	// 解析失败时，返回的错误是 `*csv.ParseError`，
+	// 其中记录了出错的行号、列号和具体的原因。
+	// 这里第二条记录的字段数与第一条不一致。
+	const bad = "a,b\nc,d,e\n"
+	r = csv.NewReader(strings.NewReader(bad))
+	_, err = r.ReadAll()
+	var perr *csv.ParseError
+	if errors.As(err, &perr) {
+		fmt.Println("line:", perr.Line,
+			"column:", perr.Column)
+		fmt.Println("error:", perr.Err)
+	}
+	fmt.Println(err)
+}
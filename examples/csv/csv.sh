@@ -0,0 +1,12 @@
+$ go run csv.go
+name,city,quote
+Alice,Paris,"hello, world"
+Bob,Berlin,"say ""hi"""
+3 fields: ["name" "city" "quote"]
+3 fields: ["Alice" "Paris" "hello, world"]
+3 fields: ["Bob" "Berlin" "say \"hi\""]
+[[id price] [1 9,99] [2 0,50]]
+[["a" "5\" screen" "b"]] <nil>
+line: 2 column: 1
+error: wrong number of fields
+record on line 2: wrong number of fields
@@ -0,0 +1,99 @@
+// 在[错误处理](errors)的例子中，我们已经见过了自定义的错误类型。
+// 这里我们进一步看看如何让自定义错误参与到错误链中，
+// 以及如何使用 Go 1.20 引入的 `errors.Join` 组合多个错误。
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// 哨兵错误（sentinel error）是一个预先定义好的错误值，
+// 调用者使用 `errors.Is` 与它进行比较。
+var errEmpty = errors.New("empty")
+var errRange = errors.New("out of range")
+
+// `fieldError` 是一个自定义的错误类型：除了错误信息，
+// 它还携带了出错的字段名和值，调用者可以取出这些信息。
+// 它包装了导致错误的底层错误 `err`。
+type fieldError struct {
+	field string
+	value any
+	err   error
+}
+
+// 实现 `Error` 方法后，`*fieldError` 就满足了 `error` 接口。
+func (e *fieldError) Error() string {
+	return fmt.Sprintf("invalid %s %#v: %v",
+		e.field, e.value, e.err)
+}
+
+// `Unwrap` 方法返回被包装的错误，这样 `errors.Is` 和
+// `errors.As` 就能沿着错误链找到 `err`。
+func (e *fieldError) Unwrap() error {
+	return e.err
+}
+
+type user struct {
+	name  string
+	email string
+	age   int
+}
+
+// `validate` 检查所有的字段，而不是在第一个错误处就返回。
+// `errors.Join` 将所有的错误组合为一个错误，
+// 它会忽略 `nil`，如果所有的参数都是 `nil`，它返回 `nil`。
+func validate(u user) error {
+	var errs []error
+	invalid := func(field string, value any, err error) {
+		e := &fieldError{field, value, err}
+		errs = append(errs, e)
+	}
+	if u.name == "" {
+		invalid("name", u.name, errEmpty)
+	}
+	if u.email == "" {
+		invalid("email", u.email, errEmpty)
+	}
+	if u.age < 0 || u.age > 150 {
+		invalid("age", u.age, errRange)
+	}
+	return errors.Join(errs...)
+}
+
+func main() {
+	ok := user{"gopher", "gopher@go.dev", 15}
+	fmt.Println(validate(ok))
+
+	// 组合后的错误信息由各个错误的信息以换行符连接而成。
+	err := validate(user{email: "", age: 200})
+	fmt.Println(err)
+
+	// `errors.Is` 会检查组合错误中的每一个错误，
+	// 以及它们各自的错误链。
+	fmt.Println("empty:", errors.Is(err, errEmpty))
+	fmt.Println("range:", errors.Is(err, errRange))
+
+	// `errors.As` 找到错误树中第一个可以赋值给目标的错误，
+	// 并把它存入目标中，我们借此取出自定义错误的字段。
+	// 即使它又被 `%w` 包装了一层，也同样可以找到。
+	wrapped := fmt.Errorf("signup: %w", err)
+	var fe *fieldError
+	if errors.As(wrapped, &fe) {
+		fmt.Printf("first: %s %#v\n", fe.field, fe.value)
+	}
+
+	// `errors.Join` 返回的错误实现了 `Unwrap() []error` 方法，
+	// 通过它可以逐个遍历被组合的错误。
+	type multi interface{ Unwrap() []error }
+	if joined, ok := err.(multi); ok {
+		for _, e := range joined.Unwrap() {
+			if errors.As(e, &fe) {
+				fmt.Printf("%s: %v\n", fe.field, fe.err)
+			} else {
+				fmt.Println("other:", e)
+			}
+		}
+	}
+}
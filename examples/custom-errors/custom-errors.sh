@@ -0,0 +1,13 @@
+# 校验通过时，`errors.Join` 返回 `nil`；否则组合后的错误
+# 包含了每个字段的错误，每行一个。
+$ go run custom-errors.go
+<nil>
+invalid name "": empty
+invalid email "": empty
+invalid age 200: out of range
+empty: true
+range: true
+first: name ""
+name: empty
+email: empty
+age: out of range
@@ -0,0 +1,149 @@
+// `database/sql` 包为各种 SQL 数据库提供了一套通用的接口，
+// 具体的数据库则由对应的 *驱动* 来支持。
+// 这里我们使用纯 Go 实现的 SQLite 驱动 `modernc.org/sqlite`，
+// 它不依赖 cgo，并且可以使用内存数据库，非常适合用来演示。
+
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	// 驱动包通常只需要匿名导入：
+	// 它在 `init` 函数中将自己注册为 `sqlite` 驱动。
+	_ "modernc.org/sqlite"
+)
+
+func check(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// `transfer` 在一个 *事务* 中执行多条语句：
+// 要么全部生效，要么在出错时通过 `Rollback` 全部撤销。
+func transfer(db *sql.DB, from, to string, n int) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	// 如果事务已经提交，`Rollback` 什么也不做，
+	// 因此通过 defer 调用它来保证出错时的回滚是安全的。
+	defer tx.Rollback()
+
+	_, err = tx.Exec("UPDATE users SET coins = coins - ?"+
+		" WHERE name = ?", n, from)
+	if err != nil {
+		return err
+	}
+	var left int
+	err = tx.QueryRow(
+		"SELECT coins FROM users WHERE name = ?",
+		from).Scan(&left)
+	if err != nil {
+		return err
+	}
+	if left < 0 {
+		return fmt.Errorf("%s has only %d coins",
+			from, left+n)
+	}
+	_, err = tx.Exec("UPDATE users SET coins = coins + ?"+
+		" WHERE name = ?", n, to)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func main() {
+
+	// `sql.Open` 并不会立即建立连接，只是准备好一个连接池。
+	// 每个连接到 `:memory:` 的连接都会得到一个独立的内存数据库，
+	// 所以这里将连接池限制为一个连接。
+	db, err := sql.Open("sqlite", ":memory:")
+	check(err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	// `Exec` 用于执行不返回行的语句。
+	_, err = db.Exec(`CREATE TABLE users (
+		id    INTEGER PRIMARY KEY,
+		name  TEXT NOT NULL UNIQUE,
+		coins INTEGER NOT NULL
+	)`)
+	check(err)
+
+	// 需要多次执行的语句，可以先用 `Prepare` 准备好。
+	// `?` 是参数占位符，参数由驱动负责转义，
+	// 永远不要通过拼接字符串来构造 SQL，以免产生 SQL 注入。
+	stmt, err := db.Prepare(
+		"INSERT INTO users (name, coins) VALUES (?, ?)")
+	check(err)
+	defer stmt.Close()
+	for _, u := range []struct {
+		name  string
+		coins int
+	}{{"alice", 30}, {"bob", 20}, {"carol", 10}} {
+		res, err := stmt.Exec(u.name, u.coins)
+		check(err)
+		id, err := res.LastInsertId()
+		check(err)
+		fmt.Println("inserted", u.name, "with id", id)
+	}
+
+	// `Query` 返回多行结果。使用 `rows.Next` 逐行遍历，
+	// 并用 `rows.Scan` 将各列读取到变量中。
+	// 一定要 `defer rows.Close()`，以释放其占用的连接。
+	rows, err := db.Query(
+		"SELECT name, coins FROM users WHERE coins > ?"+
+			" ORDER BY name", 15)
+	check(err)
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		var coins int
+		check(rows.Scan(&name, &coins))
+		fmt.Println("row:", name, coins)
+	}
+	// 遍历结束后，还要检查遍历过程中是否出现了错误。
+	check(rows.Err())
+
+	// `QueryRow` 用于至多返回一行的查询，
+	// 错误会推迟到调用 `Scan` 时再返回。
+	var coins int
+	err = db.QueryRow(
+		"SELECT coins FROM users WHERE name = ?",
+		"bob").Scan(&coins)
+	check(err)
+	fmt.Println("bob has", coins, "coins")
+
+	// 如果没有查询到任何行，`Scan` 返回 `sql.ErrNoRows`。
+	// 这通常不是真正的错误，需要单独处理。
+	err = db.QueryRow(
+		"SELECT coins FROM users WHERE name = ?",
+		"dave").Scan(&coins)
+	if errors.Is(err, sql.ErrNoRows) {
+		fmt.Println("dave: no such user")
+	} else {
+		check(err)
+	}
+
+	// 第一次转账成功并被提交；
+	// 第二次转账因余额不足而失败，事务中的修改全部被回滚。
+	err = transfer(db, "alice", "carol", 5)
+	fmt.Println("transfer:", err)
+	err = transfer(db, "carol", "bob", 100)
+	fmt.Println("transfer:", err)
+
+	rows, err = db.Query(
+		"SELECT name, coins FROM users ORDER BY id")
+	check(err)
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		check(rows.Scan(&name, &coins))
+		fmt.Println("balance:", name, coins)
+	}
+	check(rows.Err())
+}
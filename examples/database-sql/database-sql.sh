@@ -0,0 +1,16 @@
+# 首先下载 SQLite 驱动。
+$ go get modernc.org/sqlite
+
+$ go run database-sql.go
+inserted alice with id 1
+inserted bob with id 2
+inserted carol with id 3
+row: alice 30
+row: bob 20
+bob has 20 coins
+dave: no such user
+transfer: <nil>
+transfer: carol has only 15 coins
+balance: alice 25
+balance: bob 20
+balance: carol 15
@@ -0,0 +1,82 @@
+// _哲学家就餐问题_ 是一个经典的并发问题：
+// 5 位哲学家围坐在圆桌旁，每两位之间放着一把叉子。
+// 哲学家需要同时拿起左右两边的叉子才能吃饭。
+//
+// 如果每位哲学家都先拿起左手边的叉子，再拿右手边的，
+// 就可能出现所有人同时拿起了左边的叉子，
+// 然后都在等待右边的叉子的情况：每个人都在等待下一个人，
+// 形成了一个 _循环等待_，谁也无法继续，程序就死锁了。
+//
+// 解决的办法之一是给资源排序：把叉子编号，
+// 每位哲学家总是先拿编号较小的叉子。这样最后一位哲学家
+// 拿叉子的顺序与其他人相反，等待关系不会再形成环，
+// 至少有一个人总能拿到两把叉子。
+
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	philosophers = 5
+	meals        = 3
+)
+
+// 每把叉子是一个 `sync.Mutex`，同一时刻只能被一个人持有。
+type fork struct {
+	sync.Mutex
+}
+
+// `dine` 让第 `id` 位哲学家吃 `meals` 顿饭。
+// 这位哲学家左右两边的叉子分别是 `id` 和 `(id+1) % philosophers`，
+// 先锁定编号较小的那一把。
+func dine(id int, forks []*fork, eaten []int) {
+	first, second := id, (id+1)%philosophers
+	if first > second {
+		first, second = second, first
+	}
+	for range meals {
+		forks[first].Lock()
+		forks[second].Lock()
+
+		// 吃饭，也就是在持有两把叉子的时候做一点点工作。
+		eaten[id]++
+		time.Sleep(time.Millisecond)
+
+		forks[second].Unlock()
+		forks[first].Unlock()
+
+		// 思考一会儿，给邻座的人一个拿到叉子的机会。
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func main() {
+
+	forks := make([]*fork, philosophers)
+	for i := range forks {
+		forks[i] = new(fork)
+	}
+
+	// 每位哲学家只修改 `eaten` 中属于自己的元素，
+	// 所以不需要额外的锁。`wg.Wait` 返回之后，
+	// 所有的修改对主协程都是可见的。
+	eaten := make([]int, philosophers)
+	var wg sync.WaitGroup
+	for id := range philosophers {
+		wg.Go(func() { dine(id, forks, eaten) })
+	}
+	wg.Wait()
+
+	// 哲学家们吃饭的先后顺序每次都不同，
+	// 所以我们只在最后打印每个人吃了几顿饭。
+	total := 0
+	for id, n := range eaten {
+		fmt.Printf("philosopher %d ate %d meals\n", id, n)
+		total += n
+	}
+	fmt.Println("total meals:", total)
+}
@@ -0,0 +1,11 @@
+# 每位哲学家都吃完了 3 顿饭，程序没有死锁。
+# 如果去掉 `dine` 中的排序，程序就有可能死锁，
+# 所有协程都阻塞时，运行时会报告
+# `fatal error: all goroutines are asleep - deadlock!`。
+$ go run dining-philosophers.go
+philosopher 0 ate 3 meals
+philosopher 1 ate 3 meals
+philosopher 2 ate 3 meals
+philosopher 3 ate 3 meals
+philosopher 4 ate 3 meals
+total meals: 15
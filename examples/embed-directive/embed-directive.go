@@ -0,0 +1,81 @@
+// noplay
+
+// `//go:embed` 是一个编译器指令，它可以在编译时将
+// 文件或目录的内容打包进二进制程序中。
+// 这样，程序运行时就不再需要依赖这些文件的存在。
+
+package main
+
+// 即使没有直接用到 `embed` 包中的任何标识符，
+// 也必须导入它才能使用 `//go:embed`。没有用到 `embed.FS`
+// 时，可以使用空白导入 `_ "embed"`。
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+)
+
+// `//go:embed` 指令必须紧挨着一个包级别变量的声明，
+// 中间只能有空行和注释。路径相对于源文件所在的目录，
+// 并且不能包含 `..`，也就是不能引用包目录以外的文件。
+// 单个文件可以嵌入到 `string` 中……
+//
+//go:embed assets/version.txt
+var version string
+
+// ……或者 `[]byte` 中。
+//
+//go:embed assets/hello.txt
+var hello []byte
+
+// 多个文件或整个目录需要嵌入到 `embed.FS` 中，
+// 它实现了 `io/fs` 包中的 `fs.FS` 接口。
+// 一条指令可以列出多个路径，也可以使用通配符。
+//
+//go:embed assets
+var assets embed.FS
+
+func main() {
+
+	// 嵌入的内容就像普通的变量一样使用。
+	fmt.Print("version: ", version)
+	fmt.Print("hello: ", string(hello))
+
+	// `fs.ReadFile` 从 `embed.FS` 中读取一个文件，
+	// 路径总是使用 `/` 分隔，在 Windows 上也是如此。
+	const readme = "assets/docs/readme.txt"
+	data, err := fs.ReadFile(assets, readme)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print("readme: ", string(data))
+
+	// `fs.WalkDir` 以字典序遍历文件系统中的所有条目。
+	walk := func(path string, d fs.DirEntry,
+		err error) error {
+		if err != nil {
+			return err
+		}
+		fmt.Println("walk:", path, d.IsDir())
+		return nil
+	}
+	if err := fs.WalkDir(assets, ".", walk); err != nil {
+		panic(err)
+	}
+
+	// `fs.Sub` 返回以某个子目录为根的文件系统。
+	// Web 服务器常常用它提供嵌入的静态文件，例如
+	// `http.FileServer(http.FS(sub))`。
+	// 这里只是列出根目录下的条目。
+	sub, err := fs.Sub(assets, "assets")
+	if err != nil {
+		panic(err)
+	}
+	entries, err := fs.ReadDir(sub, ".")
+	if err != nil {
+		panic(err)
+	}
+	for _, e := range entries {
+		fmt.Println("entry:", e.Name())
+	}
+}
@@ -0,0 +1,13 @@
+$ go run embed-directive.go
+version: v1.2.3
+hello: hello, embed
+readme: embedded docs
+walk: . true
+walk: assets true
+walk: assets/docs true
+walk: assets/docs/readme.txt false
+walk: assets/hello.txt false
+walk: assets/version.txt false
+entry: docs
+entry: hello.txt
+entry: version.txt
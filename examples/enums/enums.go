@@ -0,0 +1,111 @@
+// Go 没有专门的枚举类型，但使用具名的整数类型、
+// 一组常量以及 `iota`，就可以实现枚举的功能。
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 首先定义一个新的类型，它的底层类型是 `int`。
+type state int
+
+// `iota` 在每个 `const` 块中从 0 开始，每一行递增 1。
+// 只有第一个常量需要写出类型和表达式，
+// 后面的常量会重复上一行的表达式，只是 `iota` 的值不同。
+const (
+	stateIdle state = iota
+	stateConnected
+	stateError
+	stateRetrying
+)
+
+// 为了让枚举值打印成可读的名字，我们为它实现
+// `fmt.Stringer` 接口。在实际的项目中，通常使用代码生成工具
+// [`stringer`](https://pkg.go.dev/golang.org/x/tools/cmd/stringer)
+// 自动生成这个方法，这里为了清楚起见，我们手动实现它。
+var stateNames = map[state]string{
+	stateIdle:      "idle",
+	stateConnected: "connected",
+	stateError:     "error",
+	stateRetrying:  "retrying",
+}
+
+func (s state) String() string {
+	if name, ok := stateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("state(%d)", int(s))
+}
+
+// 反过来，从字符串解析出枚举值。
+// 对于未知的名字，返回一个错误。
+func parseState(name string) (state, error) {
+	for s, n := range stateNames {
+		if strings.EqualFold(n, name) {
+			return s, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown state %q", name)
+}
+
+// 使用 `_` 可以跳过不需要的值。
+// 这里我们让第一个有意义的值从 1 开始，
+// 这样零值就不会被误认为是一个有效的选项。
+type weekday int
+
+const (
+	_ weekday = iota
+	monday
+	tuesday
+	wednesday
+)
+
+// `iota` 也可以出现在表达式中。
+// 例如 `1 << (10 * iota)` 依次得到 1024 的各次幂。
+type size int64
+
+const (
+	_       = iota
+	kb size = 1 << (10 * iota)
+	mb
+	gb
+)
+
+func main() {
+
+	// 由于实现了 `String` 方法，`fmt` 会打印枚举值的名字。
+	// 使用 `%d` 仍然可以得到它的数值。
+	s := stateConnected
+	fmt.Println(s)
+	fmt.Printf("%v = %d\n", stateRetrying, stateRetrying)
+
+	// 不在定义范围内的值也能被转换为 `state`，
+	// 所以 `String` 需要处理这种情况。
+	fmt.Println(state(7))
+
+	// `switch` 搭配枚举值使用十分自然。
+	switch s {
+	case stateIdle:
+		fmt.Println("waiting")
+	case stateConnected:
+		fmt.Println("ready")
+	default:
+		fmt.Println("trouble")
+	}
+
+	// 解析字符串。
+	for _, name := range []string{"Error", "dead"} {
+		s, err := parseState(name)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Printf("parsed %d: %v\n", s, s)
+	}
+
+	// 在新的 `const` 块中，`iota` 重新从 0 开始计数。
+	fmt.Println(monday, tuesday, wednesday)
+	fmt.Println(kb, mb, gb)
+}
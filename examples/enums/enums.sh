@@ -0,0 +1,9 @@
+$ go run enums.go
+connected
+retrying = 3
+state(7)
+ready
+parsed 2: error
+unknown state "dead"
+1 2 3
+1024 1048576 1073741824
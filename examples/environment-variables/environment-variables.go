@@ -10,21 +10,60 @@ import (
 	"strings"
 )
 
+// 读取配置时常见的做法是：环境变量存在时使用它的值，
+// 否则使用一个默认值。借助 `os.LookupEnv`，
+// 显式设置为空字符串的变量不会被当作“未设置”。
+func getenv(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return fallback
+}
+
 func main() {
 
 	// 使用 `os.Setenv` 来设置一个键值对。
-	// 使用 `os.Getenv`获取一个键对应的值。
+	// 使用 `os.Getenv` 获取一个键对应的值。
 	// 如果键不存在，将会返回一个空字符串。
-	os.Setenv("FOO", "1")
-	fmt.Println("FOO:", os.Getenv("FOO"))
-	fmt.Println("BAR:", os.Getenv("BAR"))
+	// 为了让输出固定，这个例子只使用以 `GBE_` 开头的变量。
+	os.Setenv("GBE_FOO", "1")
+	os.Setenv("GBE_EMPTY", "")
+	fmt.Println("GBE_FOO:", os.Getenv("GBE_FOO"))
+	fmt.Println("GBE_BAR:", os.Getenv("GBE_BAR"))
+
+	// `os.Getenv` 无法区分“未设置”和“设置为空”，
+	// 这时可以使用返回两个值的 `os.LookupEnv`，
+	// 第二个值表示这个变量是否存在。
+	v, ok := os.LookupEnv("GBE_EMPTY")
+	fmt.Printf("GBE_EMPTY: %q %v\n", v, ok)
+	v, ok = os.LookupEnv("GBE_BAR")
+	fmt.Printf("GBE_BAR: %q %v\n", v, ok)
+
+	// 使用上面的 `getenv` 读取带默认值的配置。
+	fmt.Println("port:", getenv("GBE_PORT", "8080"))
+	os.Setenv("GBE_PORT", "9090")
+	fmt.Println("port:", getenv("GBE_PORT", "8080"))
 
 	// 使用 `os.Environ` 来列出所有环境变量键值对。
 	// 这个函数会返回一个 `KEY=value` 形式的字符串切片。
-	// 你可以使用 `strings.SplitN` 来得到键和值。这里我们打印所有的键。
+	// 你可以使用 `strings.Cut` 来得到键和值。
+	// 这里我们只打印自己设置的那几个变量。
 	fmt.Println()
 	for _, e := range os.Environ() {
-		pair := strings.SplitN(e, "=", 2)
-		fmt.Println(pair[0])
+		key, val, _ := strings.Cut(e, "=")
+		if strings.HasPrefix(key, "GBE_") {
+			fmt.Printf("%s=%q\n", key, val)
+		}
 	}
+
+	// 使用 `os.Unsetenv` 删除一个变量，
+	// 之后 `os.LookupEnv` 就会报告它不存在了。
+	os.Unsetenv("GBE_FOO")
+	_, ok = os.LookupEnv("GBE_FOO")
+	fmt.Println()
+	fmt.Println("GBE_FOO set:", ok)
+
+	// 注意，这些修改只对当前进程有效，不会影响启动它的 shell。
+	// 不过，当前进程之后启动的子进程会继承修改后的环境变量，
+	// 参考[生成进程](spawning-processes)的例子。
 }
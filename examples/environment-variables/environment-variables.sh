@@ -1,17 +1,24 @@
-# 运行这个程序，显示我们在程序中设置的 `FOO` 的值，
-# 然而没有设置的 `BAR` 是空的。
+# 运行这个程序，显示我们在程序中设置的 `GBE_FOO` 的值，
+# 然而没有设置的 `GBE_BAR` 是空的。
 $ go run environment-variables.go
-FOO: 1
-BAR: 
+GBE_FOO: 1
+GBE_BAR: 
+GBE_EMPTY: "" true
+GBE_BAR: "" false
+port: 8080
+port: 9090
 
-# 键的列表是由你的电脑情况而定的。
-TERM_PROGRAM
-PATH
-SHELL
-...
+GBE_FOO="1"
+GBE_EMPTY=""
+GBE_PORT="9090"
+
+GBE_FOO set: false
 
-# 如果我们在运行前设置了 `BAR` 的值，那么运行程序将会获取到这个值。
-$ BAR=2 go run environment-variables.go
-FOO: 1
-BAR: 2
+# 如果我们在运行前设置了 `GBE_BAR` 的值，
+# 那么运行程序将会获取到这个值。
+$ GBE_BAR=2 go run environment-variables.go
+GBE_FOO: 1
+GBE_BAR: 2
+GBE_EMPTY: "" true
+GBE_BAR: "2" true
 ...
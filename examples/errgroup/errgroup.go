@@ -0,0 +1,96 @@
+// [WaitGroup](waitgroups) 可以等待一组协程完成，
+// 但无法得知它们是否执行成功。
+// `golang.org/x/sync/errgroup` 包在此基础上增加了错误处理：
+// 它等待一组协程完成，并返回其中第一个非 nil 的错误。
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// 这个错误模拟了某个任务的失败。
+var errBroken = errors.New("broken pipe")
+
+func main() {
+
+	// `errgroup.Group` 的零值就可以直接使用。
+	// `g.Go` 在新的协程中运行给定的函数，
+	// `g.Wait` 等待所有函数返回，然后返回第一个非 nil 的错误。
+	var g errgroup.Group
+	names := []string{"alpha", "beta", "gamma"}
+	sizes := make([]int, len(names))
+	for i, name := range names {
+		g.Go(func() error {
+			if name == "beta" {
+				return fmt.Errorf("fetch %s: %w",
+					name, errBroken)
+			}
+			sizes[i] = len(name)
+			return nil
+		})
+	}
+	err := g.Wait()
+	fmt.Println("wait:", err)
+	fmt.Println("sizes:", sizes)
+
+	// `errgroup.WithContext` 额外返回一个 context，
+	// 一旦某个函数返回错误，这个 context 就会被取消。
+	// 其他仍在运行的函数应当关注 `ctx.Done()`，并尽快退出。
+	g2, ctx := errgroup.WithContext(context.Background())
+	status := make([]string, 3)
+	for i := range status {
+		g2.Go(func() error {
+			if i == 0 {
+				status[i] = "failed"
+				return errBroken
+			}
+			select {
+			case <-time.After(time.Second):
+				status[i] = "finished"
+			case <-ctx.Done():
+				status[i] = "canceled: " +
+					ctx.Err().Error()
+			}
+			return nil
+		})
+	}
+	err = g2.Wait()
+	fmt.Println("wait:", err)
+
+	// 各个协程把结果写入属于自己的位置，
+	// 在 `Wait` 返回之后按顺序打印，输出就是确定的。
+	for i, s := range status {
+		fmt.Println("worker", i, s)
+	}
+
+	// `SetLimit` 限制同时运行的协程数量，
+	// 达到上限时，`g.Go` 会阻塞，直到有协程返回，
+	// 效果与[工作池](worker-pools)中的信号量相同。
+	// `TryGo` 则不会阻塞：达到上限时它直接返回 `false`，
+	// 不启动这个函数。这里的两个协程会一直运行，
+	// 直到 `release` 被关闭，所以第三次调用失败了。
+	var g3 errgroup.Group
+	g3.SetLimit(2)
+	release := make(chan struct{})
+	for i := 0; i < 3; i++ {
+		started := g3.TryGo(func() error {
+			<-release
+			return nil
+		})
+		fmt.Println("try", i, "started:", started)
+	}
+	close(release)
+	fmt.Println("wait:", g3.Wait())
+
+	// 所有协程都返回之后，又可以启动新的协程了。
+	fmt.Println("after wait:", g3.TryGo(func() error {
+		return nil
+	}))
+	g3.Wait()
+}
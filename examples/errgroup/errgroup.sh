@@ -0,0 +1,15 @@
+# `errgroup` 不在标准库中，需要先下载这个依赖。
+$ go get golang.org/x/sync/errgroup
+
+$ go run errgroup.go
+wait: fetch beta: broken pipe
+sizes: [5 0 5]
+wait: broken pipe
+worker 0 failed
+worker 1 canceled: context canceled
+worker 2 canceled: context canceled
+try 0 started: true
+try 1 started: true
+try 2 started: false
+wait: <nil>
+after wait: true
@@ -0,0 +1,77 @@
+// 从 Go 1.13 开始，我们可以 *包装（wrap）* 一个错误：
+// 在保留原始错误的同时，为它附加更多的上下文信息。
+// `errors` 包中的 `errors.Is` 和 `errors.As`
+// 可以沿着这条错误链，查找我们关心的错误。
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// *哨兵错误（sentinel error）* 是一个预先声明的错误变量，
+// 调用者通过与它比较来判断发生了哪种错误。
+var ErrNotFound = errors.New("not found")
+
+// 自定义的错误类型可以携带额外的字段。
+type QueryError struct {
+	Query string
+	Err   error
+}
+
+func (e *QueryError) Error() string {
+	return e.Query + ": " + e.Err.Error()
+}
+
+// 实现 `Unwrap` 方法后，`errors.Is` 和 `errors.As`
+// 就能透过 `QueryError` 继续检查它所包装的错误。
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
+
+func find(key string) error {
+	return &QueryError{Query: key, Err: ErrNotFound}
+}
+
+// 在 `fmt.Errorf` 中使用 `%w` 动词来包装错误。
+// 与 `%v` 不同，`%w` 会保留被包装的错误，使其可以被解包。
+// 这里我们包装了两层：`load` 包装了 `find` 返回的错误，
+// 而 `find` 返回的 `QueryError` 又包装了 `ErrNotFound`。
+func load(key string) error {
+	if err := find(key); err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+	return nil
+}
+
+func main() {
+
+	err := load("timeout")
+
+	// 打印包装后的错误，可以看到每一层附加的上下文。
+	fmt.Println(err)
+
+	// 以前用 `==` 与哨兵错误进行比较的方式，
+	// 一旦错误被包装就不再奏效了。
+	fmt.Println("err == ErrNotFound:", err == ErrNotFound)
+
+	// `errors.Is` 会递归地解包错误链，
+	// 只要链中的某个错误等于目标错误，就会返回 `true`。
+	fmt.Println("errors.Is(err, ErrNotFound):",
+		errors.Is(err, ErrNotFound))
+	fmt.Println("errors.Is(err, os.ErrNotExist):",
+		errors.Is(err, os.ErrNotExist))
+
+	// `errors.As` 在错误链中查找第一个与目标类型匹配的错误，
+	// 匹配成功时，会将其赋值给目标变量并返回 `true`。
+	var qe *QueryError
+	if errors.As(err, &qe) {
+		fmt.Println("errors.As found query:", qe.Query)
+	}
+
+	// `errors.Unwrap` 每次只解开一层包装。
+	fmt.Println(errors.Unwrap(err))
+	fmt.Println(errors.Unwrap(errors.Unwrap(err)))
+}
@@ -0,0 +1,11 @@
+$ go run error-wrapping.go
+load config: timeout: not found
+err == ErrNotFound: false
+errors.Is(err, ErrNotFound): true
+errors.Is(err, os.ErrNotExist): false
+errors.As found query: timeout
+timeout: not found
+not found
+
+# 关于错误包装的更多信息，可以阅读 Go 官方博客中的
+# [Working with Errors in Go 1.13](https://go.dev/blog/go1.13-errors)。
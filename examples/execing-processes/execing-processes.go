@@ -1,3 +1,6 @@
+// norun
+// noplay
+
 // 在前面的例子中，我们了解了[生成外部进程](spawning-processes)的知识，
 // 当我们需要在运行的 Go 流程中访问的外部流程时，便可以执行此操作。
 // 但是有时候，我们只想用其它（也许是非 Go）的进程，来完全替代当前的 Go 进程。
@@ -1,3 +1,5 @@
+// norun
+
 // 使用 `os.Exit` 可以立即以给定的状态退出程序。
 
 package main
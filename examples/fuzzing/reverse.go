@@ -0,0 +1,24 @@
+// 从 Go 1.18 开始，`go test` 原生支持 *模糊测试（fuzzing）*。
+// 模糊测试会不断生成随机的输入，并用它们调用被测试的代码，
+// 以此发现人工编写的用例难以覆盖的边界情况。
+
+// 我们要对下面这个反转字符串的函数进行模糊测试，
+// 测试代码位于 `reverse_test.go` 中。
+
+package main
+
+import "fmt"
+
+// `Reverse` 按 rune 而不是按字节反转字符串，
+// 这样多字节的 UTF-8 字符才不会被拆开。
+func Reverse(s string) string {
+	r := []rune(s)
+	for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+	return string(r)
+}
+
+func main() {
+	fmt.Println(Reverse("Hello, 世界"))
+}
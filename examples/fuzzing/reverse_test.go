@@ -0,0 +1,47 @@
+// noplay
+
+package main
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+// 模糊测试函数的名称以 `Fuzz` 开头，并接受一个 `*testing.F` 参数。
+func FuzzReverse(f *testing.F) {
+
+	// `f.Add` 向 *种子语料库（seed corpus）* 中添加输入。
+	// 普通的 `go test` 只会使用这些种子运行模糊测试，
+	// 就像运行一组普通的单元测试一样。
+	// 而 `go test -fuzz` 会以它们为起点，变换出新的输入。
+	seeds := []string{"Hello, world", " ", "!12345", "世界"}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	// `f.Fuzz` 的参数是 *模糊测试目标*，它的参数类型
+	// 需要与 `f.Add` 添加的值一一对应。
+	// 我们无法预知生成的输入，所以不能与固定的结果比较，
+	// 而是检查那些对任何输入都应该成立的 *性质*。
+	f.Fuzz(func(t *testing.T, s string) {
+
+		// 生成的输入可以是任意字节序列，不一定是合法的 UTF-8。
+		// `[]rune` 会把非法的字节替换为 `U+FFFD`，
+		// 对这类输入来说反转两次不会还原，因此跳过它们。
+		if !utf8.ValidString(s) {
+			t.Skip("invalid UTF-8")
+		}
+		rev := Reverse(s)
+
+		// 反转两次应该得到原来的字符串。
+		if got := Reverse(rev); got != s {
+			t.Errorf("Reverse(Reverse(%q)) = %q", s, got)
+		}
+
+		// 合法的 UTF-8 字符串反转后仍然是合法的 UTF-8。
+		if !utf8.ValidString(rev) {
+			t.Errorf("Reverse(%q) = %q: invalid UTF-8",
+				s, rev)
+		}
+	})
+}
@@ -0,0 +1,39 @@
+# 普通的 `go test` 只使用种子语料库运行模糊测试，
+# 每个种子对应一个子测试。
+$ go test -v
+=== RUN   FuzzReverse
+=== RUN   FuzzReverse/seed#0
+=== RUN   FuzzReverse/seed#1
+=== RUN   FuzzReverse/seed#2
+=== RUN   FuzzReverse/seed#3
+--- PASS: FuzzReverse (0.00s)
+    --- PASS: FuzzReverse/seed#0 (0.00s)
+    --- PASS: FuzzReverse/seed#1 (0.00s)
+    --- PASS: FuzzReverse/seed#2 (0.00s)
+    --- PASS: FuzzReverse/seed#3 (0.00s)
+PASS
+ok  	examples/fuzzing	0.002s
+
+# 加上 `-fuzz` 标志后，`go test` 会在种子的基础上
+# 不断生成新的输入，直到发现失败或被手动中断。
+# `-fuzztime` 可以限制模糊测试的运行时间。
+$ go test -fuzz=FuzzReverse -fuzztime=10s
+fuzz: elapsed: 0s, gathering baseline coverage:
+  0/4 completed
+fuzz: elapsed: 0s, gathering baseline coverage:
+  4/4 completed, now fuzzing with 8 workers
+fuzz: elapsed: 3s, execs: 411207 (137064/sec),
+  new interesting: 23 (total: 27)
+fuzz: elapsed: 6s, execs: 846738 (145172/sec),
+  new interesting: 25 (total: 29)
+fuzz: elapsed: 9s, execs: 1270591 (141283/sec),
+  new interesting: 25 (total: 29)
+fuzz: elapsed: 10s, execs: 1407696 (137151/sec),
+  new interesting: 25 (total: 29)
+PASS
+ok  	examples/fuzzing	10.101s
+
+# 如果发现了导致失败的输入，它会被保存到
+# `testdata/fuzz/FuzzReverse` 目录下。
+# 此后普通的 `go test` 也会运行这个输入，
+# 从而防止问题再次出现。
@@ -0,0 +1,169 @@
+// [泛型](generics)的类型参数都需要一个 _约束_，
+// 它决定了可以用哪些类型实例化，
+// 以及在函数体内可以对这个类型的值做哪些操作：
+//
+//   - `any` 不做任何限制，但也只允许赋值、传参这类
+//     对所有类型都成立的操作；
+//   - `comparable` 允许使用 `==` 和 `!=`，
+//     map 的键的类型就必须满足它；
+//   - 包含方法的接口要求类型实现这些方法，
+//     于是可以在函数体内调用它们；
+//   - 包含类型并集（例如 `~int | ~float64`）的接口
+//     只能用作约束，它允许并集中所有类型都支持的运算符。
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// `Set` 是一个基于 map 的集合。map 的键必须可以比较，
+// 所以 `T` 的约束是 `comparable`；
+// 值的类型是不占用内存的 `struct{}`。
+type Set[T comparable] map[T]struct{}
+
+func (s Set[T]) Add(vs ...T) {
+	for _, v := range vs {
+		s[v] = struct{}{}
+	}
+}
+
+func (s Set[T]) Contains(v T) bool {
+	_, ok := s[v]
+	return ok
+}
+
+func (s Set[T]) Remove(v T) { delete(s, v) }
+
+func (s Set[T]) Len() int { return len(s) }
+
+// 遍历集合的顺序和遍历 map 一样是不确定的。
+// 排序需要 `<`，而 `comparable` 并不保证它，
+// 方法又不能声明自己的类型参数，
+// 所以 `Sorted` 是一个约束更严格的函数：
+// `cmp.Ordered` 包含了所有支持 `<` 的类型，
+// 即整数、浮点数和字符串，以及以它们为底层类型的类型。
+func Sorted[T cmp.Ordered](s Set[T]) []T {
+	r := make([]T, 0, len(s))
+	for v := range s {
+		r = append(r, v)
+	}
+	slices.Sort(r)
+	return r
+}
+
+// `Max` 返回参数中最大的一个。
+// Go 1.21 也加入了内置函数 `max`，可以直接使用。
+func Max[T cmp.Ordered](first T, rest ...T) T {
+	m := first
+	for _, v := range rest {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
+
+// `SortDescending` 使用 `cmp.Compare` 从大到小排序。
+// `cmp.Compare(a, b)` 在 a < b、a == b、a > b 时
+// 分别返回 -1、0、+1，交换参数的顺序就得到了降序。
+func SortDescending[T cmp.Ordered](s []T) {
+	slices.SortFunc(s, func(a, b T) int {
+		return cmp.Compare(b, a)
+	})
+}
+
+// `Signed` 是一个自定义的类型并集约束。
+// `~` 表示也包括以这些类型为底层类型的自定义类型。
+type Signed interface {
+	~int | ~int32 | ~int64
+}
+
+// 并集中的每个类型都支持一元的 `-`，
+// 所以函数体内可以对 `T` 使用它。
+func Abs[T Signed](v T) T {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// 约束也可以同时嵌入类型集合与方法：
+// 满足 `orderedStringer` 的类型既要是有序的，
+// 还要实现 `String` 方法。
+type orderedStringer interface {
+	cmp.Ordered
+	String() string
+}
+
+type celsius float64
+
+func (c celsius) String() string {
+	return fmt.Sprintf("%.1f°C", float64(c))
+}
+
+func Hottest[T orderedStringer](t T, ts ...T) string {
+	return Max(t, ts...).String()
+}
+
+// 只包含方法的接口也可以作为约束。
+// 与直接接受 `[]fmt.Stringer` 不同，
+// 调用者可以直接传入 `[]celsius`，不需要先转换每个元素。
+func Join[T fmt.Stringer](s []T, sep string) string {
+	parts := make([]string, len(s))
+	for i, v := range s {
+		parts[i] = v.String()
+	}
+	return strings.Join(parts, sep)
+}
+
+type person struct {
+	first, last string
+}
+
+func main() {
+
+	s := Set[string]{}
+	s.Add("go", "rust", "zig", "go")
+	s.Remove("rust")
+	fmt.Println(s.Len(), s.Contains("go"),
+		s.Contains("rust"))
+	fmt.Println(Sorted(s))
+
+	fmt.Println(Max(3, 7, 5), Max("b", "c", "a"))
+	nums := []float64{2.5, -1, 9}
+	SortDescending(nums)
+	fmt.Println(nums)
+
+	// 这里的 `T` 分别被推断为 `int` 和 `int32`。
+	// 底层类型为 `float64` 的 `celsius` 不在 `Signed` 中，
+	// 所以 `Abs(celsius(-1))` 无法通过编译。
+	fmt.Println(Abs(-4), Abs(int32(-9)))
+
+	temps := []celsius{21.5, 30, 18.5}
+	fmt.Println(Hottest(temps[0], temps[1:]...))
+	fmt.Println(Join(temps, ", "))
+
+	// `cmp.Or` 返回参数中第一个不是零值的值。
+	// 把几个 `cmp.Compare` 的结果传给它，
+	// 就得到了一个按多个键排序的比较函数：
+	// 先比较姓，姓相同时再比较名。
+	people := []person{
+		{"Ada", "Lovelace"}, {"Alan", "Turing"},
+		{"Grace", "Hopper"}, {"Ada", "Hopper"},
+	}
+	slices.SortFunc(people, func(a, b person) int {
+		return cmp.Or(
+			cmp.Compare(a.last, b.last),
+			cmp.Compare(a.first, b.first),
+		)
+	})
+	fmt.Println(people)
+
+	// 它也常用于提供默认值。
+	name := ""
+	fmt.Println(cmp.Or(name, "anonymous"))
+}
@@ -0,0 +1,12 @@
+# 集合按排好的顺序打印，
+# 人按照先姓后名的顺序排序。
+$ go run generic-constraints.go
+2 true false
+[go zig]
+7 c
+[9 2.5 -1]
+4 9
+30.0°C
+21.5°C, 30.0°C, 18.5°C
+[{Ada Hopper} {Grace Hopper} {Ada Lovelace} {Alan Turing}]
+anonymous
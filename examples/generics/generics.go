@@ -2,14 +2,29 @@
 
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
 
-// 作为泛型函数的示例，`MapKeys` 接受任意类型的Map并返回其Key的切片。
-// 这个函数有2个类型参数 - `K` 和 `V`；
+// 作为泛型函数的示例，`Map` 对切片中的每个元素调用 `f`，
+// 并返回由结果组成的新切片。
+// 这个函数有2个类型参数 - `T` 和 `U`，
+// 它们都是 `any` 类型，意味着它们不受任何限制
+// (`any` 是 `interface{}` 的别名类型)。
+func Map[T, U any](s []T, f func(T) U) []U {
+	r := make([]U, 0, len(s))
+	for _, v := range s {
+		r = append(r, f(v))
+	}
+	return r
+}
+
+// `Keys` 接受任意类型的Map并返回其Key的切片。
 // `K` 是 `comparable` 类型，也就是说我们可以通过 `==` 和 `!=`
 // 操作符对这个类型的值进行比较。这是Go中Map的Key所必须的。
-// `V` 是 `any` 类型，意味着它不受任何限制 (`any` 是 `interface{}` 的别名类型).
-func MapKeys[K comparable, V any](m map[K]V) []K {
+func Keys[K comparable, V any](m map[K]V) []K {
 	r := make([]K, 0, len(m))
 	for k := range m {
 		r = append(r, k)
@@ -17,52 +32,84 @@ func MapKeys[K comparable, V any](m map[K]V) []K {
 	return r
 }
 
-// 作为泛型类型的示例， `List` 是一个
-// 具有任意类型值的单链表。
-type List[T any] struct {
-	head, tail *element[T]
+// 我们也可以用接口定义自己的 *约束（constraint）*。
+// `Number` 允许所有底层类型为 `int` 或 `float64` 的类型，
+// `~` 表示包含以它们为底层类型的自定义类型。
+type Number interface {
+	~int | ~float64
+}
+
+// `Sum` 只能接受满足 `Number` 约束的类型，
+// 因此可以在函数体内对 `T` 类型的值使用 `+` 运算符。
+func Sum[T Number](s []T) T {
+	var total T
+	for _, v := range s {
+		total += v
+	}
+	return total
 }
 
-type element[T any] struct {
-	next *element[T]
-	val  T
+// 作为泛型类型的示例， `Stack` 是一个
+// 具有任意类型值的栈。
+type Stack[T any] struct {
+	items []T
 }
 
 // 我们可以像在常规类型上一样定义泛型类型的方法
 // 但我们必须保留类型参数。
-// 这个类型是 `List[T]`，而不是 `List`
-func (lst *List[T]) Push(v T) {
-	if lst.tail == nil {
-		lst.head = &element[T]{val: v}
-		lst.tail = lst.head
-	} else {
-		lst.tail.next = &element[T]{val: v}
-		lst.tail = lst.tail.next
-	}
+// 这个类型是 `Stack[T]`，而不是 `Stack`
+func (s *Stack[T]) Push(v T) {
+	s.items = append(s.items, v)
 }
 
-func (lst *List[T]) GetAll() []T {
-	var elems []T
-	for e := lst.head; e != nil; e = e.next {
-		elems = append(elems, e.val)
+// `Pop` 在栈为空时返回 `T` 的零值和 `false`。
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
 	}
-	return elems
+	v := s.items[len(s.items)-1]
+	s.items = s.items[:len(s.items)-1]
+	return v, true
 }
 
+// 自定义类型 `Celsius` 的底层类型是 `float64`，
+// 因此它同样满足 `Number` 约束。
+type Celsius float64
+
 func main() {
-	var m = map[int]string{1: "2", 2: "4", 4: "8"}
 
 	// 当调用泛型函数的时候, 我们经常可以使用类型推断。
-	// 注意，当调用 `MapKeys` 的时候，
-	// 我们不需要为 `K` 和 `V` 指定类型 - 编译器会进行自动推断
-	fmt.Println("keys m:", MapKeys(m))
+	// 注意，当调用 `Map` 的时候，
+	// 我们不需要为 `T` 和 `U` 指定类型 - 编译器会进行自动推断
+	nums := []int{1, 2, 3}
+	fmt.Printf("map: %q\n", Map(nums, strconv.Itoa))
 
 	// ... 虽然我们也可以明确指定这些类型。
-	_ = MapKeys[int, string](m)
+	sq := Map[int, int](nums, func(n int) int {
+		return n * n
+	})
+	fmt.Println("map:", sq)
+
+	// Map 的遍历顺序是不确定的，
+	// 所以这里在打印之前对 key 进行了排序。
+	var m = map[int]string{1: "2", 2: "4", 4: "8"}
+	keys := Keys(m)
+	sort.Ints(keys)
+	fmt.Println("keys:", keys)
+
+	fmt.Println("sum:", Sum(nums))
+	fmt.Println("sum:", Sum([]float64{1.5, 2.5}))
+	fmt.Println("sum:", Sum([]Celsius{20.5, 3}))
 
-	lst := List[int]{}
-	lst.Push(10)
-	lst.Push(13)
-	lst.Push(23)
-	fmt.Println("list:", lst.GetAll())
+	// 使用泛型类型时，需要提供类型参数。
+	var s Stack[string]
+	s.Push("a")
+	s.Push("b")
+	v, ok := s.Pop()
+	fmt.Println("pop:", v, ok)
+	v, ok = s.Pop()
+	fmt.Println("pop:", v, ok)
+	v, ok = s.Pop()
+	fmt.Printf("pop: %q %v\n", v, ok)
 }
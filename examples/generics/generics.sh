@@ -1,2 +1,10 @@
-keys: [4 1 2]
-list: [10 13 23]
\ No newline at end of file
+$ go run generics.go
+map: ["1" "2" "3"]
+map: [1 4 9]
+keys: [1 2 4]
+sum: 6
+sum: 4
+sum: 23.5
+pop: b true
+pop: a true
+pop: "" false
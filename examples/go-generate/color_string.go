@@ -0,0 +1,26 @@
+// Code generated by "stringer -type=Color"; DO NOT EDIT.
+
+package main
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[Red-0]
+	_ = x[Green-1]
+	_ = x[Blue-2]
+}
+
+const _Color_name = "RedGreenBlue"
+
+var _Color_index = [...]uint8{0, 3, 8, 12}
+
+func (i Color) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_Color_index)-1 {
+		return "Color(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _Color_name[_Color_index[idx]:_Color_index[idx+1]]
+}
@@ -0,0 +1,54 @@
+// noplay
+
+// `go generate` 用来运行生成代码的工具。它会扫描源文件中
+// 形如 `//go:generate 命令 参数...` 的特殊注释，并依次执行这些命令。
+// 与 `go build` 不同，`go generate` 从不自动运行，
+// 需要由开发者在修改了相关代码之后显式地执行。
+// 常见的生成工具有 `stringer`、`protoc-gen-go`（Protocol Buffers）、
+// `mockgen`（接口的 mock 实现）以及 `goyacc`（语法分析器）等等，
+// 不过 `go generate` 可以运行任何命令，并不局限于 Go 工具。
+
+package main
+
+import "fmt"
+
+// 下面的指令使用 [`stringer`](https://pkg.go.dev/golang.org/x/tools/cmd/stringer)
+// 为 `Color` 类型生成 `String` 方法。`//go:generate` 与注释符号之间
+// 不能有空格。命令在这个源文件所在的目录中执行，
+// `stringer` 会把生成的代码写入 `color_string.go`。
+//
+// 先安装 `stringer`：
+// `go install golang.org/x/tools/cmd/stringer@latest`，
+// 然后在模块的根目录运行 `go generate ./...`。
+// 也可以写成 `//go:generate go run golang.org/x/tools/cmd/stringer@latest -type=Color`，
+// 这样就不需要提前安装它了。
+//
+//go:generate stringer -type=Color
+
+// `Color` 是一个使用 `iota` 定义的枚举类型，
+// 参考[枚举](enums)的例子。
+type Color int
+
+const (
+	Red Color = iota
+	Green
+	Blue
+)
+
+func main() {
+
+	// `color_string.go` 中生成的 `String` 方法让 `Color`
+	// 满足了 `fmt.Stringer` 接口，打印时会输出常量的名字。
+	fmt.Println(Red, Green, Blue)
+
+	// 超出定义范围的值会打印为 `Color(数值)`。
+	fmt.Println(Color(7))
+
+	// 按照惯例，生成的文件会和其他代码一起提交到版本库中，
+	// 这样使用这个包的人不需要安装生成工具就能直接构建它。
+	// 生成的文件以 `// Code generated ... DO NOT EDIT.` 开头，
+	// 工具和代码审查会据此识别它们，不应该手动修改它们。
+	// 修改了 `Color` 的常量之后，需要重新运行 `go generate`。
+	fmt.Printf("%v is %d\n", Blue, Blue)
+
+}
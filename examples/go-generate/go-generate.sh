@@ -0,0 +1,12 @@
+# 安装 `stringer` 之后，运行 `go generate`
+# 生成 `color_string.go`。使用 `-x` 可以打印执行的命令。
+$ go install golang.org/x/tools/cmd/stringer@latest
+$ go generate -x ./...
+stringer -type=Color
+
+# 生成的文件和手写的代码一起编译，
+# 这里我们运行整个包，而不是单个文件。
+$ go run .
+Red Green Blue
+Color(7)
+Blue is 2
@@ -0,0 +1,99 @@
+// `encoding/gob` 是 Go 自己的二进制序列化格式。
+// 与 [JSON](json) 相比，它更紧凑、编解码更快，
+// 但只适合在 Go 程序之间传递数据，例如 `net/rpc` 就使用了它。
+
+package main
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"io"
+)
+
+// 与 JSON 一样，只有导出的字段才会被编码，
+// 未导出的字段 `secret` 在解码之后会是零值。
+type point struct {
+	X, Y   int
+	Label  string
+	secret string
+}
+
+// `shape` 是一个接口，我们将编码保存在接口中的值。
+type shape interface {
+	Area() float64
+}
+
+type rect struct {
+	W, H float64
+}
+
+func (r rect) Area() float64 { return r.W * r.H }
+
+func main() {
+
+	// `gob.NewEncoder` 将值编码后写入一个 `io.Writer`，
+	// 这里使用内存中的缓冲区代替文件或者网络连接。
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	p := point{X: 3, Y: 4, Label: "a", secret: "hidden"}
+	if err := enc.Encode(p); err != nil {
+		panic(err)
+	}
+
+	// `gob.NewDecoder` 从 `io.Reader` 中解码出值。
+	dec := gob.NewDecoder(&buf)
+	var q point
+	if err := dec.Decode(&q); err != nil {
+		panic(err)
+	}
+	fmt.Printf("decoded: %+v\n", q)
+
+	// gob 按照字段的 *名称* 而不是顺序进行匹配：
+	// 目标类型中缺少的字段会被忽略，多出的字段保持零值，
+	// 因此结构体定义的变化不会轻易破坏已经编码的数据。
+	// 这里的 `point3` 没有 `Label`，但多了一个 `Z`。
+	type point3 struct {
+		Z, Y, X int
+	}
+	if err := enc.Encode(p); err != nil {
+		panic(err)
+	}
+	var r point3
+	if err := dec.Decode(&r); err != nil {
+		panic(err)
+	}
+	fmt.Printf("point3: %+v\n", r)
+
+	// 切片等复合类型同样可以直接编码。
+	// 注意，同一个 `Encoder` 只会发送一次类型的定义，
+	// 所以同一个流中的值需要由同一个 `Decoder` 解码。
+	points := []point{{X: 1}, {Y: 2, Label: "b"}}
+	if err := enc.Encode(points); err != nil {
+		panic(err)
+	}
+	var decoded []point
+	if err := dec.Decode(&decoded); err != nil {
+		panic(err)
+	}
+	for _, p := range decoded {
+		fmt.Printf("slice: %+v\n", p)
+	}
+
+	// 编码保存在接口中的值时，gob 需要知道它的具体类型。
+	// `gob.Register` 注册具体类型，同时也为它起了一个名称，
+	// 解码时，这个名称被用来找到对应的类型。
+	// 没有注册时，`Encode` 会返回错误。
+	var s shape = rect{W: 2, H: 3}
+	err := gob.NewEncoder(io.Discard).Encode(&s)
+	fmt.Println("error:", err)
+	gob.Register(rect{})
+	if err := enc.Encode(&s); err != nil {
+		panic(err)
+	}
+	var t shape
+	if err := dec.Decode(&t); err != nil {
+		panic(err)
+	}
+	fmt.Printf("shape: %T %+v area: %v\n", t, t, t.Area())
+}
@@ -0,0 +1,7 @@
+$ go run gob.go
+decoded: {X:3 Y:4 Label:a secret:}
+point3: {Z:0 Y:4 X:3}
+slice: {X:1 Y:0 Label: secret:}
+slice: {X:0 Y:2 Label:b secret:}
+error: gob: type not registered for interface: main.rect
+shape: main.rect {W:2 H:3} area: 6
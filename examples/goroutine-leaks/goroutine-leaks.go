@@ -0,0 +1,85 @@
+// 协程非常廉价，但并不是免费的：一个永远阻塞的协程
+// 不会被垃圾回收，它占用的内存以及它引用的所有对象
+// 会一直保留到程序退出。这被称为 _协程泄漏_。
+// 避免泄漏的规则很简单：每个协程都必须有明确的退出路径。
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// `search` 模拟一次耗时不同的查询。
+func search(i int) string {
+	time.Sleep(time.Duration(i) * 10 * time.Millisecond)
+	return fmt.Sprint("result ", i)
+}
+
+// `leaky` 并发地进行三次查询，并返回最先得到的结果。
+// 问题在于，通道 `ch` 是无缓冲的，在 `leaky` 取走
+// 第一个结果并返回之后，就再也没有人从中接收了，
+// 另外两个协程会永远阻塞在发送操作上。
+func leaky() string {
+	ch := make(chan string)
+	for i := 1; i <= 3; i++ {
+		go func() {
+			ch <- search(i)
+		}()
+	}
+	return <-ch
+}
+
+// 第一种修复的方法是使用带缓冲的通道，
+// 缓冲区足够容纳所有的结果，因此发送永远不会阻塞。
+// 没有被接收的值会随通道一起被垃圾回收。
+func buffered() string {
+	ch := make(chan string, 3)
+	for i := 1; i <= 3; i++ {
+		go func() {
+			ch <- search(i)
+		}()
+	}
+	return <-ch
+}
+
+// 另一种方法是使用 [context](context)：调用者返回时
+// 取消 `ctx`，协程在 `select` 中同时等待发送成功和
+// `ctx.Done()`，哪个先发生就执行哪个，然后退出。
+// 当协程还需要停止其他工作时，这种方法更加通用；
+// 一个专门用于通知退出的 `done` 通道也可以达到同样的效果。
+func withContext() string {
+	ctx, cancel := context.WithCancel(
+		context.Background())
+	defer cancel()
+	ch := make(chan string)
+	for i := 1; i <= 3; i++ {
+		go func() {
+			r := search(i)
+			select {
+			case ch <- r:
+			case <-ctx.Done():
+			}
+		}()
+	}
+	return <-ch
+}
+
+// `leaked` 调用 `f`，稍等一会儿，让能够结束的协程真正退出，
+// 然后返回调用前后协程数量的变化。
+// `runtime.NumGoroutine` 返回当前存在的协程数量，
+// 在测试中比较它在前后的变化，是发现泄漏的一个简单方法。
+func leaked(f func() string) int {
+	before := runtime.NumGoroutine()
+	f()
+	time.Sleep(100 * time.Millisecond)
+	return runtime.NumGoroutine() - before
+}
+
+func main() {
+	fmt.Println("leaky:", leaked(leaky))
+	fmt.Println("buffered:", leaked(buffered))
+	fmt.Println("context:", leaked(withContext))
+}
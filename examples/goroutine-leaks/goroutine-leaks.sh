@@ -0,0 +1,5 @@
+# 泄漏的版本留下了两个永远阻塞的协程。
+$ go run goroutine-leaks.go
+leaky: 2
+buffered: 0
+context: 0
@@ -1,3 +1,5 @@
+// norun
+
 // _协程(goroutine)_ 是轻量级的执行线程。
 
 package main
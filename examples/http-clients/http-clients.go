@@ -1,35 +1,119 @@
 // Go 标准库的 `net/http` 包为 HTTP 客户端和服务端提供了出色的支持。
-// 在这个例子中，我们将使用它发送简单的 HTTP 请求。
+// 在这个例子中，我们将使用它发送 HTTP 请求，并解析返回的 JSON。
 
 package main
 
 import (
-	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"time"
 )
 
+// 为了让例子不依赖外部网络，我们先使用 `httptest`
+// 在本地启动一个服务器：`/user` 返回一段固定的 JSON，
+// 而 `/slow` 则要等很久才会响应。
+func newServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user", func(w http.ResponseWriter,
+		r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(user{
+			Name: "gopher",
+			ID:   42,
+			Via:  r.Header.Get("User-Agent"),
+		})
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter,
+		r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+	})
+	return httptest.NewServer(mux)
+}
+
+// 我们将 response body 解码到这个结构体中。
+type user struct {
+	Name string `json:"name"`
+	ID   int    `json:"id"`
+	Via  string `json:"via"`
+}
+
 func main() {
+	srv := newServer()
+	defer srv.Close()
 
-	// 向服务端发送一个 HTTP GET 请求。
-	// `http.Get` 是创建 `http.Client` 对象并调用其 `Get` 方法的快捷方式。
-	// 它使用了 `http.DefaultClient` 对象及其默认设置。
-	resp, err := http.Get("http://gobyexample.com")
+	// `http.Get` 是使用 `http.DefaultClient` 发送请求的快捷方式，
+	// 但默认的客户端没有超时时间，一个无响应的服务器会让请求永远挂起。
+	// 因此最好创建自己的 `http.Client`，并设置 `Timeout`。
+	client := &http.Client{
+		Timeout: 200 * time.Millisecond,
+	}
+
+	// `http.NewRequestWithContext` 创建一个带 context 的请求，
+	// 通过它我们还可以设置请求的 header。
+	req, err := http.NewRequestWithContext(
+		context.Background(), "GET", srv.URL+"/user", nil)
 	if err != nil {
 		panic(err)
 	}
+	req.Header.Set("User-Agent", "gobyexample")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		panic(err)
+	}
+
+	// 读取完 response 后，一定要关闭 body。
+	// 只有当 body 被完整读取并关闭后，底层的 TCP 连接才能被复用；
+	// 否则客户端只能为后续请求建立新的连接。
 	defer resp.Body.Close()
 
-	// 打印 HTTP response 状态.
+	// 打印 HTTP response 状态。
+	// 注意，即使服务器返回了 404 或 500，`Do` 也不会返回错误，
+	// 所以需要自己检查状态码。
 	fmt.Println("Response status:", resp.Status)
+	if resp.StatusCode != http.StatusOK {
+		panic(resp.Status)
+	}
 
-	// 打印 response body 前面 5 行的内容。
-	scanner := bufio.NewScanner(resp.Body)
-	for i := 0; scanner.Scan() && i < 5; i++ {
-		fmt.Println(scanner.Text())
+	// `json.NewDecoder` 直接从 body 中以流的方式解码 JSON，
+	// 无需先将整个 body 读入内存。
+	var u user
+	err = json.NewDecoder(resp.Body).Decode(&u)
+	if err != nil {
+		panic(err)
 	}
+	fmt.Printf("user: %+v\n", u)
+
+	// 解码器可能没有读到 body 的末尾，
+	// 将剩余的内容读取并丢弃，以便连接可以被复用。
+	io.Copy(io.Discard, resp.Body)
 
-	if err := scanner.Err(); err != nil {
+	// 使用 `context.WithTimeout` 可以为单个请求设置截止时间。
+	// 在超时之后，请求会被取消，`Do` 返回的错误
+	// 包装了 `context.DeadlineExceeded`。
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err = http.NewRequestWithContext(
+		ctx, "GET", srv.URL+"/slow", nil)
+	if err != nil {
 		panic(err)
 	}
+	_, err = client.Do(req)
+	fmt.Println("deadline exceeded:",
+		errors.Is(err, context.DeadlineExceeded))
+
+	// 如果没有 context 的截止时间，客户端的 `Timeout` 会生效。
+	_, err = client.Get(srv.URL + "/slow")
+	var netErr interface{ Timeout() bool }
+	fmt.Println("client timeout:",
+		errors.As(err, &netErr) && netErr.Timeout())
 }
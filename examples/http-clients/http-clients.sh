@@ -1,7 +1,5 @@
 $ go run http-clients.go
 Response status: 200 OK
-<!DOCTYPE html>
-<html>
-  <head>
-    <meta charset="utf-8">
-    <title>Go by Example</title>
+user: {Name:gopher ID:42 Via:gobyexample}
+deadline exceeded: true
+client timeout: true
@@ -3,8 +3,17 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/signal"
+	"sort"
+	"time"
 )
 
 // *handlers* 是 `net/http` 服务器里面的一个基本概念。
@@ -13,29 +22,116 @@ import (
 func hello(w http.ResponseWriter, req *http.Request) {
 
 	// handler 函数有两个参数，`http.ResponseWriter` 和 `http.Request`。
-	// response writer 被用于写入 HTTP 响应数据，这里我们简单的返回 "hello\n"。
-	fmt.Fprintf(w, "hello\n")
+	// response writer 被用于写入 HTTP 响应数据。
+	// `req.PathValue` 返回路由模式中通配符 `{name}` 匹配到的那段路径。
+	fmt.Fprintf(w, "hello, %s\n", req.PathValue("name"))
 }
 
 func headers(w http.ResponseWriter, req *http.Request) {
 
 	// 这个 handler 稍微复杂一点，
 	// 我们需要读取的 HTTP 请求 header 中的所有内容，并将他们输出至 response body。
-	for name, headers := range req.Header {
-		for _, h := range headers {
+	// header 保存在 map 中，这里先将名称排序，以固定输出的顺序。
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, h := range req.Header[name] {
 			fmt.Fprintf(w, "%v: %v\n", name, h)
 		}
 	}
 }
 
+// `newMux` 创建一个 `http.ServeMux` 路由，并在其中注册 handler。
+// 从 Go 1.22 开始，路由模式可以包含 HTTP 方法和通配符：
+// `GET /hello/{name}` 只匹配 GET（以及 HEAD）请求，
+// 其他方法的请求会得到 `405 Method Not Allowed` 响应。
+func newMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /hello/{name}", hello)
+	mux.HandleFunc("/headers", headers)
+	return mux
+}
+
+// `get` 向服务器发送请求，并打印响应的状态和内容。
+func get(method, url string) {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		panic(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%s %s\n%s", method, resp.Status, body)
+}
+
 func main() {
 
-	// 使用 `http.HandleFunc` 函数，可以方便的将我们的 handler 注册到服务器路由。
-	// 它是 `net/http` 包中的默认路由，接受一个函数作为参数。
-	http.HandleFunc("/hello", hello)
-	http.HandleFunc("/headers", headers)
+	// 与直接调用 `http.ListenAndServe` 相比，
+	// 自己创建 `http.Server` 可以配置更多的选项。
+	// 例如设置读写超时，防止缓慢的客户端长时间占用连接。
+	srv := &http.Server{
+		Handler:      newMux(),
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+
+	// 通常我们会监听一个固定的端口，例如 `:8090`。
+	// 这里监听 `127.0.0.1:0`，由操作系统分配一个空闲的端口。
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	base := "http://" + ln.Addr().String()
+
+	// `Serve` 会一直阻塞，所以在协程中运行它。
+	// 服务器被关闭后，它返回 `http.ErrServerClosed`。
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve(ln)
+	}()
+
+	// 当程序收到中断信号（例如按下 Ctrl+C）时，`ctx` 会被取消。
+	// 为了让例子可以自动结束，我们在发送完请求之后，
+	// 调用 `stop` 来模拟收到了信号。
+	ctx, stop := signal.NotifyContext(
+		context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		get("GET", base+"/hello/gopher")
+		get("POST", base+"/hello/gopher")
+		get("GET", base+"/headers")
+		stop()
+	}()
+	<-ctx.Done()
+
+	// `Shutdown` 会优雅地关闭服务器：它不再接受新的连接，
+	// 并等待正在处理的请求完成。
+	// 传入一个带超时的 context，避免无限期地等待下去。
+	fmt.Println("server: shutting down")
+	shutdownCtx, cancel := context.WithTimeout(
+		context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		panic(err)
+	}
+	err = <-serveErr
+	if !errors.Is(err, http.ErrServerClosed) {
+		panic(err)
+	}
+	fmt.Println("server: stopped")
 
-	// 最后，我们调用 `ListenAndServe` 并带上端口和 handler。
-	// nil 表示使用我们刚刚设置的默认路由器。
-	http.ListenAndServe(":8090", nil)
+	// 在测试中，可以使用 `httptest.NewServer`
+	// 在随机端口上启动同样的 handler，用完之后关闭即可。
+	ts := httptest.NewServer(newMux())
+	defer ts.Close()
+	get("GET", ts.URL+"/hello/test")
 }
@@ -1,6 +1,20 @@
-# 后台运行服务器。
-$ go run http-servers.go &
+# 运行服务器。程序会向自己发送几个请求，打印响应，
+# 然后优雅地关闭服务器。
+# 注意 POST 请求不匹配 `GET /hello/{name}` 路由。
+$ go run http-servers.go
+GET 200 OK
+hello, gopher
+POST 405 Method Not Allowed
+Method Not Allowed
+GET 200 OK
+Accept-Encoding: gzip
+User-Agent: Go-http-client/1.1
+server: shutting down
+server: stopped
+GET 200 OK
+hello, test
 
-# 访问 `/hello` 路由。
-$ curl localhost:8090/hello
-hello
+# 如果监听的是固定端口 `:8090`，
+# 也可以在服务器运行时使用 curl 访问 `/hello/{name}` 路由。
+$ curl localhost:8090/hello/gopher
+hello, gopher
@@ -0,0 +1,121 @@
+// `io.Reader` 和 `io.Writer` 可能是标准库中最重要的两个接口。
+// 文件、网络连接、缓冲区、压缩流……几乎所有和数据流相关的类型
+// 都实现了它们，所以只依赖这两个接口的代码，可以与它们任意组合。
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// `io.Reader` 只有一个方法：`Read(p []byte) (n int, err error)`。
+// 正确地实现它需要遵守一些约定：
+//   - `Read` 最多读取 `len(p)` 个字节，但可以读取得更少，
+//     调用者不能假设一次调用就能填满 `p`；
+//   - 数据读完时返回 `io.EOF`，它可以和最后的数据一起返回
+//     （`n > 0` 且 `err == io.EOF`），也可以在下一次调用时单独返回；
+//   - 调用者应该先处理返回的 `n` 个字节，再检查错误；
+//   - 除非 `len(p) == 0`，否则不应该返回 `0, nil`。
+//
+// `repeat` 无限地重复一个字节，它永远不会返回 `io.EOF`。
+type repeat byte
+
+func (r repeat) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = byte(r)
+	}
+	return len(p), nil
+}
+
+// `io.Writer` 同样只有一个方法：`Write(p []byte) (n int, err error)`。
+// 与 `Read` 不同，如果 `Write` 没有写完全部数据，
+// 它必须返回一个非 `nil` 的错误。
+// `counter` 丢弃写入的数据，只统计字节数和写入的次数。
+type counter struct {
+	bytes, writes int
+}
+
+func (c *counter) Write(p []byte) (int, error) {
+	c.bytes += len(p)
+	c.writes++
+	return len(p), nil
+}
+
+// 较大的接口由较小的接口组合而成，例如 `io.ReadCloser`
+// 就是 `io.Reader` 加上 `io.Closer`。
+// `closeLogger` 为任意的 `io.Writer` 添加一个 `Close` 方法，
+// 使它满足 `io.WriteCloser`。嵌入的 `io.Writer` 提供了 `Write`。
+type closeLogger struct {
+	io.Writer
+	name string
+}
+
+func (c closeLogger) Close() error {
+	fmt.Println("closed", c.name)
+	return nil
+}
+
+func main() {
+
+	// 无限的 reader 可以用 `io.LimitReader` 截断，
+	// 它读取 `n` 个字节之后就返回 `io.EOF`。
+	// `io.Copy` 不断地从 reader 读取、写入 writer，直到 `io.EOF`，
+	// 它返回复制的字节数。
+	c := &counter{}
+	src := io.LimitReader(repeat('x'), 100000)
+	n, err := io.Copy(c, src)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("copied:", n, "bytes:", c.bytes)
+
+	// `io.Copy` 使用一块 32KB 的缓冲区，所以 `Write` 被调用了 4 次。
+	fmt.Println("writes:", c.writes)
+
+	// `bufio.Writer` 收集小的写入，攒满缓冲区后再一次性写入
+	// 底层的 writer，减少了调用的次数。`io.WriteString`
+	// 向任意一个 writer 写入字符串。别忘了最后调用 `Flush`。
+	c = &counter{}
+	w := bufio.NewWriterSize(c, 1024)
+	for range 1000 {
+		io.WriteString(w, "hello")
+	}
+	w.Flush()
+	fmt.Println("buffered:", c.bytes, "bytes")
+	fmt.Println("writes:", c.writes)
+
+	// `bytes.Buffer` 同时实现了 `io.Reader` 和 `io.Writer`：
+	// 写入的数据追加到末尾，读取则从头部取走数据。
+	var buf bytes.Buffer
+	io.WriteString(&buf, "line one\nline two\n")
+	fmt.Fprintf(&buf, "line %s\n", "three")
+
+	// `io.ReadAll` 读取 reader 中的所有数据，直到 `io.EOF`。
+	// 这里我们先用 `bufio.Reader` 读走第一行。
+	r := bufio.NewReader(&buf)
+	first, _ := r.ReadString('\n')
+	rest, err := io.ReadAll(r)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("first: %q\n", first)
+	fmt.Printf("rest: %q\n", rest)
+
+	// `io.NopCloser` 为一个 reader 加上什么都不做的 `Close`，
+	// 从而满足返回 `io.ReadCloser` 的 API，例如 HTTP 请求的 body。
+	sr := strings.NewReader("body")
+	var rc io.ReadCloser = io.NopCloser(sr)
+	body, _ := io.ReadAll(rc)
+	rc.Close()
+	fmt.Println("body:", string(body))
+
+	// 同样地，`closeLogger` 满足了 `io.WriteCloser`。
+	var wc io.WriteCloser = closeLogger{&buf, "buffer"}
+	io.WriteString(wc, "written")
+	wc.Close()
+	fmt.Println("buffer:", buf.String())
+}
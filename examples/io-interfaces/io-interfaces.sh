@@ -0,0 +1,12 @@
+# 由于缓冲区的存在，10 万个字节只用了 4 次写入，
+# 1000 次小的写入也被合并成了 5 次。
+$ go run io-interfaces.go
+copied: 100000 bytes: 100000
+writes: 4
+buffered: 5000 bytes
+writes: 5
+first: "line one\n"
+rest: "line two\nline three\n"
+body: body
+closed buffer
+buffer: written
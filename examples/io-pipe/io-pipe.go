@@ -0,0 +1,84 @@
+// `io` 包中的 `Reader` 和 `Writer` 接口可以像积木一样组合。
+// 这里我们用 `io.Pipe` 在两个协程之间传递数据流，
+// 并使用 `io` 包中的其他工具复制、分发和截断数据流。
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+func main() {
+
+	// `io.Pipe` 创建一对同步的、在内存中的管道：
+	// 写入 `PipeWriter` 的数据可以从 `PipeReader` 中读出。
+	// 管道没有内部缓冲区，每次 `Write` 都会阻塞，
+	// 直到读取者把数据全部读走。这就是 *背压（backpressure）*：
+	// 生产者的速度不会超过消费者，内存占用也不会无限增长。
+	pr, pw := io.Pipe()
+
+	// 因为写入会阻塞，生产者必须运行在另一个协程中，
+	// 否则读写双方会互相等待，造成死锁。
+	go func() {
+		var err error
+		for i := 1; i <= 3; i++ {
+			_, err = fmt.Fprintf(pw, "line %d\n", i)
+			if err != nil {
+				break
+			}
+		}
+		// 写完之后一定要关闭写入端，读取者才会收到 `io.EOF`。
+		// `CloseWithError` 的参数为 `nil` 时，与 `Close` 相同。
+		pw.CloseWithError(err)
+	}()
+
+	// `io.Copy` 从读取端读取数据，直到 `io.EOF`，
+	// 然后将其写入一个缓冲区。
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, pr)
+	fmt.Printf("copied %d bytes, err: %v\n", n, err)
+	fmt.Print(buf.String())
+
+	// 如果生产者失败了，可以将错误传给 `CloseWithError`，
+	// 读取者读完已有的数据之后，会得到这个错误。
+	pr, pw = io.Pipe()
+	go func() {
+		io.WriteString(pw, "partial data\n")
+		pw.CloseWithError(errors.New("producer failed"))
+	}()
+	buf.Reset()
+	_, err = io.Copy(&buf, pr)
+	fmt.Printf("got %q, err: %v\n", buf.String(), err)
+
+	// `io.TeeReader` 包装一个 reader，读取它的同时，
+	// 把读到的数据写入一个 writer，就像 Unix 的 `tee` 命令。
+	// 例如，可以在处理数据流的同时计算校验和或保存一份副本。
+	var saved bytes.Buffer
+	src := strings.NewReader("hello, tee\n")
+	tee := io.TeeReader(src, &saved)
+	data, err := io.ReadAll(tee)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("read: %q\n", data)
+	fmt.Printf("saved: %q\n", saved.Bytes())
+
+	// `io.MultiWriter` 将写入的数据同时发送给多个 writer，
+	// 这里同时写入标准输出和两个缓冲区。
+	var b1, b2 bytes.Buffer
+	w := io.MultiWriter(os.Stdout, &b1, &b2)
+	fmt.Fprintln(w, "fan out")
+	fmt.Printf("b1: %q b2: %q\n", b1.Bytes(), b2.Bytes())
+
+	// `io.LimitReader` 最多读取给定字节数的数据，
+	// 之后就返回 `io.EOF`，常用于限制读取不可信的输入。
+	digits := strings.NewReader("0123456789")
+	lim := io.LimitReader(digits, 4)
+	data, err = io.ReadAll(lim)
+	fmt.Printf("limited: %q err: %v\n", data, err)
+}
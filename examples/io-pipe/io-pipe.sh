@@ -0,0 +1,11 @@
+$ go run io-pipe.go
+copied 21 bytes, err: <nil>
+line 1
+line 2
+line 3
+got "partial data\n", err: producer failed
+read: "hello, tee\n"
+saved: "hello, tee\n"
+fan out
+b1: "fan out\n" b2: "fan out\n"
+limited: "0123" err: <nil>
@@ -0,0 +1,145 @@
+// [JSON](json) 的例子展示了如何一次性编码、解码整个值。
+// 在处理文件、网络连接等数据流时，`encoding/json`
+// 还提供了 `Encoder` 和 `Decoder`，
+// 可以逐个读写流中的值，而不必先将全部数据读入内存。
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// `date` 只保存日期，我们希望把它编码为
+// `"2006-01-02"` 这样的字符串，而不是 `time.Time` 默认的
+// RFC 3339 格式。为此它实现了 `json.Marshaler` 接口……
+type date struct {
+	time.Time
+}
+
+const dateLayout = "2006-01-02"
+
+func (d date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Format(dateLayout))
+}
+
+// ……以及 `json.Unmarshaler` 接口。
+// 注意，`UnmarshalJSON` 需要修改接收者，所以使用指针接收者。
+func (d *date) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return err
+	}
+	d.Time = t
+	return nil
+}
+
+type event struct {
+	Name string `json:"name"`
+	Date date   `json:"date"`
+}
+
+func main() {
+
+	// `json.NewEncoder` 将值编码后写入一个 `io.Writer`，
+	// 每次调用 `Encode` 写入一个值，并在其后加上换行符。
+	// 这样产生的就是常见的“每行一个 JSON”格式。
+	enc := json.NewEncoder(os.Stdout)
+	utc := time.UTC
+	launch := time.Date(2009, 11, 10, 0, 0, 0, 0, utc)
+	release := time.Date(2012, 3, 28, 0, 0, 0, 0, utc)
+	enc.Encode(event{"go", date{launch}})
+	enc.Encode(event{"go1", date{release}})
+
+	// `json.NewDecoder` 从一个 `io.Reader` 中读取值。
+	// 流中可以包含多个依次排列的 JSON 值，
+	// 反复调用 `Decode`，直到它返回 `io.EOF`。
+	const stream = `{"name": "a", "date": "2024-01-02"}
+{"name": "b", "date": "2024-03-04"}`
+	dec := json.NewDecoder(strings.NewReader(stream))
+	for {
+		var e event
+		if err := dec.Decode(&e); err == io.EOF {
+			break
+		} else if err != nil {
+			panic(err)
+		}
+		fmt.Println(e.Name, e.Date.Month(), e.Date.Day())
+	}
+
+	// 如果整个流是一个很大的 JSON 数组，`Decode` 会一次读入
+	// 整个数组。`Token` 可以逐个读取 *词法单元（token）*：
+	// 先读取开头的 `[`，然后在 `More` 返回 `true` 时，
+	// 逐个解码数组中的元素，最后读取结尾的 `]`。
+	const array = `[{"name": "x"}, {"name": "y"}]`
+	dec = json.NewDecoder(strings.NewReader(array))
+	if _, err := dec.Token(); err != nil {
+		panic(err)
+	}
+	for dec.More() {
+		var e event
+		if err := dec.Decode(&e); err != nil {
+			panic(err)
+		}
+		fmt.Println("element:", e.Name)
+	}
+	if _, err := dec.Token(); err != nil {
+		panic(err)
+	}
+
+	// `Token` 也可以用于完全底层的处理。
+	// 返回的 token 是分隔符 `json.Delim`，或者是
+	// `string`、`float64`、`bool` 和 `nil` 等值。
+	const doc = `{"tags": ["go", 1, true, null]}`
+	dec = json.NewDecoder(strings.NewReader(doc))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			panic(err)
+		}
+		fmt.Printf("%T: %v\n", tok, tok)
+	}
+
+	// `json.RawMessage` 保存尚未解码的原始 JSON。
+	// 当一个字段的结构取决于另一个字段时，可以先把它
+	// 解码为 `RawMessage`，之后再根据情况进一步解码。
+	const msg = `{"type": "event",
+		"data": {"name": "gc", "date": "2024-05-06"}}`
+	var envelope struct {
+		Type string          `json:"type"`
+		Data json.RawMessage `json:"data"`
+	}
+	err := json.Unmarshal([]byte(msg), &envelope)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("raw:", string(envelope.Data))
+	if envelope.Type == "event" {
+		var e event
+		err := json.Unmarshal(envelope.Data, &e)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println("decoded:", e.Name,
+			e.Date.Format(dateLayout))
+	}
+
+	// 默认情况下，解码器会忽略结构体中不存在的字段。
+	// 调用 `DisallowUnknownFields` 后，遇到未知的字段
+	// 则返回一个错误，这有助于发现拼写错误的配置项。
+	const extra = `{"name": "a", "dat": "2024-01-02"}`
+	dec = json.NewDecoder(strings.NewReader(extra))
+	dec.DisallowUnknownFields()
+	var e event
+	fmt.Println("error:", dec.Decode(&e))
+}
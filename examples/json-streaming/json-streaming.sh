@@ -0,0 +1,19 @@
+$ go run json-streaming.go
+{"name":"go","date":"2009-11-10"}
+{"name":"go1","date":"2012-03-28"}
+a January 2
+b March 4
+element: x
+element: y
+json.Delim: {
+string: tags
+json.Delim: [
+string: go
+float64: 1
+bool: true
+<nil>: <nil>
+json.Delim: ]
+json.Delim: }
+raw: {"name": "gc", "date": "2024-05-06"}
+decoded: gc 2024-05-06
+error: json: unknown field "dat"
@@ -0,0 +1,139 @@
+// [JSON](json) 的例子中，我们用结构体标签（struct tag）
+// 指定了字段在 JSON 中的名称。`encoding/json` 的标签
+// 还支持更多的选项，它们的格式是 `json:"名称,选项..."`。
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// `meta` 会被嵌入到 `product` 中。
+type meta struct {
+	ID      int    `json:"id"`
+	Version string `json:"version,omitempty"`
+}
+
+// `product` 展示了各种常用的标签选项：
+//
+//   - `json:"name"` 重命名字段；没有标签的导出字段使用
+//     字段名本身，未导出的字段总是被忽略。
+//   - `omitempty` 在字段为“空值”时省略它：
+//     `false`、`0`、nil 指针、nil 接口，
+//     以及长度为 0 的数组、切片、map 和字符串。
+//   - `json:"-"` 让字段完全不参与编码和解码。
+//   - `,string` 把数字或布尔值编码为 JSON 字符串，
+//     例如某些 API 为了避免精度问题会这样传递大整数。
+//   - 没有标签的匿名（嵌入）结构体字段会被“展平”，
+//     它的字段就像直接属于外层结构体一样。
+//
+// 注意 `omitempty` 并不会省略结构体：即使 `Dims` 的所有
+// 字段都是零值，它也会被编码为 `{}`。`time.Time` 也是结构体，
+// 所以零值的时间同样不会被省略。从 Go 1.24 开始，
+// 可以使用 `omitzero` 选项，它会省略任何类型的零值。
+type product struct {
+	meta
+	Name     string    `json:"name"`
+	Price    int64     `json:"price,string"`
+	Tags     []string  `json:"tags,omitempty"`
+	Discount float64   `json:"discount,omitempty"`
+	Secret   string    `json:"-"`
+	Dims     dims      `json:"dims,omitempty"`
+	Created  time.Time `json:"created,omitempty"`
+	Updated  time.Time `json:"updated,omitzero"`
+	internal int
+}
+
+type dims struct {
+	W int `json:"w,omitempty"`
+	H int `json:"h,omitempty"`
+}
+
+// `patch` 描述对商品的部分更新。对于指针字段，
+// JSON 中的 `0` 会得到一个指向 0 的指针，
+// 而字段缺失和值为 `null` 得到的都是 nil，无法区分。
+type patch struct {
+	Stock *int             `json:"stock"`
+	Note  optional[string] `json:"note"`
+}
+
+// 如果需要区分“缺失”和“`null`”，可以使用自定义类型。
+// 字段在 JSON 中出现时，即使值是 `null`，
+// `UnmarshalJSON` 也会被调用；字段缺失时则不会被调用。
+type optional[T any] struct {
+	Set   bool // 字段出现在了 JSON 中
+	Valid bool // 字段的值不是 null
+	Value T
+}
+
+func (o *optional[T]) UnmarshalJSON(data []byte) error {
+	o.Set = true
+	if string(data) == "null" {
+		return nil
+	}
+	o.Valid = true
+	return json.Unmarshal(data, &o.Value)
+}
+
+func (o optional[T]) String() string {
+	switch {
+	case !o.Set:
+		return "absent"
+	case !o.Valid:
+		return "null"
+	}
+	return fmt.Sprintf("%v", o.Value)
+}
+
+func main() {
+
+	// 编码时零值的 `Tags`、`Discount` 和 `Version` 被省略了，
+	// `Secret` 和未导出的 `internal` 不会出现，
+	// `meta` 的字段被展平到了顶层。
+	p := product{
+		meta:     meta{ID: 7},
+		Name:     "gopher plush",
+		Price:    1999,
+		Secret:   "supplier-42",
+		internal: 1,
+	}
+	out, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(out))
+
+	// 解码时，JSON 中多余的字段（`color`）会被忽略，
+	// 缺失的字段保持变量原来的值，这里是零值。
+	// 因此可以先设置好默认值，再解码。
+	// `,string` 的字段在解码时也需要是字符串形式。
+	in := `{"id": 8, "name": "mug", "price": "599",
+		"color": "blue", "secret": "ignored"}`
+	q := product{Tags: []string{"default"}}
+	if err := json.Unmarshal([]byte(in), &q); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%d %q %d %q %q\n",
+		q.ID, q.Name, q.Price, q.Tags, q.Secret)
+
+	// 依次解码缺失、`null` 和零值三种情况。
+	for _, in := range []string{
+		`{}`,
+		`{"stock": null, "note": null}`,
+		`{"stock": 0, "note": ""}`,
+	} {
+		var pt patch
+		err := json.Unmarshal([]byte(in), &pt)
+		if err != nil {
+			panic(err)
+		}
+		stock := "nil"
+		if pt.Stock != nil {
+			stock = fmt.Sprint(*pt.Stock)
+		}
+		fmt.Printf("%-31s stock=%s note=%q\n",
+			in, stock, pt.Note)
+	}
+}
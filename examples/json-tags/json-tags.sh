@@ -0,0 +1,14 @@
+# 零值的字段被省略了，但结构体 `dims` 和零值的
+# `created` 仍然保留，而 `omitzero` 的 `updated` 被省略了。
+$ go run json-tags.go
+{
+  "id": 7,
+  "name": "gopher plush",
+  "price": "1999",
+  "dims": {},
+  "created": "0001-01-01T00:00:00Z"
+}
+8 "mug" 599 ["default"] ""
+{}                              stock=nil note="absent"
+{"stock": null, "note": null}   stock=nil note="null"
+{"stock": 0, "note": ""}        stock=0 note=""
@@ -9,30 +9,107 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 )
 
-func main() {
+// `upper` 从 `r` 中逐行读取输入，并将大写的版本写入 `w`。
+// 接受 `io.Reader` 和 `io.Writer` 而不是直接使用
+// `os.Stdin` 和 `os.Stdout`，这样它也可以处理文件、
+// 网络连接或者内存中的字符串，测试起来也更方便。
+func upper(r io.Reader, w io.Writer) error {
 
-	// 用带缓冲的 scanner 包装无缓冲的 `os.Stdin`，
+	// 用带缓冲的 scanner 包装无缓冲的 reader，
 	// 这为我们提供了一种方便的 `Scan` 方法，
 	// 将 scanner 前进到下一个 `令牌`（默认为：下一行）。
-	scanner := bufio.NewScanner(os.Stdin)
+	scanner := bufio.NewScanner(r)
+
+	// 同样，用 `bufio.Writer` 包装 writer，
+	// 避免为每一行都进行一次系统调用。
+	out := bufio.NewWriter(w)
 
 	for scanner.Scan() {
 		// `Text` 返回当前的 token，这里指的是输入的下一行。
 		ucl := strings.ToUpper(scanner.Text())
 
 		// 输出转换为大写后的行。
-		fmt.Println(ucl)
+		fmt.Fprintln(out, ucl)
 	}
 
 	// 检查 `Scan` 的错误。
 	// 文件结束符（EOF）是可以接受的，它不会被 `Scan` 当作一个错误。
 	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	// 最后别忘了调用 `Flush`，将缓冲区中剩余的内容写出。
+	return out.Flush()
+}
+
+// 自定义的 `bufio.SplitFunc` 可以决定如何切分 token。
+// 它返回要前进的字节数以及找到的 token；
+// 返回 `0, nil, nil` 表示需要更多的数据。
+// `commas` 按逗号切分输入。
+func commas(data []byte, eof bool) (int, []byte, error) {
+	if i := bytes.IndexByte(data, ','); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if eof && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+func main() {
+
+	// 真正的行过滤器会调用 `upper(os.Stdin, os.Stdout)`。
+	// 为了让输出固定，这里从一个字符串中读取输入。
+	input := strings.NewReader("hello\nfilter\n")
+	if err := upper(input, os.Stdout); err != nil {
 		fmt.Fprintln(os.Stderr, "error:", err)
 		os.Exit(1)
 	}
+
+	// `Split` 可以更换 scanner 切分输入的方式，
+	// 它必须在第一次调用 `Scan` 之前设置。
+	// `bufio.ScanWords` 按空白字符切分出单词。
+	text := "the quick  brown\nfox"
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	scanner.Split(bufio.ScanWords)
+	var words []string
+	for scanner.Scan() {
+		words = append(words, scanner.Text())
+	}
+	fmt.Printf("words: %q\n", words)
+
+	// 使用自定义的切分函数。
+	list := strings.NewReader("a,b,,c")
+	scanner = bufio.NewScanner(list)
+	scanner.Split(commas)
+	var fields []string
+	for scanner.Scan() {
+		fields = append(fields, scanner.Text())
+	}
+	fmt.Printf("fields: %q\n", fields)
+
+	// 默认情况下，一个 token 最长为 `bufio.MaxScanTokenSize`
+	// （64KB），遇到更长的行时，`Scan` 返回 `false`，
+	// 而 `Err` 返回 `bufio.ErrTooLong`。
+	long := strings.Repeat("x", 100*1024)
+	scanner = bufio.NewScanner(strings.NewReader(long))
+	for scanner.Scan() {
+	}
+	fmt.Println("error:", scanner.Err())
+
+	// `Buffer` 设置初始的缓冲区以及允许的最大长度，
+	// 这样就可以处理很长的行了。
+	scanner = bufio.NewScanner(strings.NewReader(long))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		fmt.Println("line length:", len(scanner.Text()))
+	}
+	fmt.Println("error:", scanner.Err())
 }
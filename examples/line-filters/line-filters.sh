@@ -1,8 +1,10 @@
-# 试一下我们的行过滤器，首先创建一个有小写行的文件。
-$ echo 'hello'   > /tmp/lines
-$ echo 'filter' >> /tmp/lines
-
-# 然后使用行过滤器来得到大写的行。
-$ cat /tmp/lines | go run line-filters.go
+# 运行这个行过滤器，得到大写的行，
+# 以及使用其他切分方式得到的结果。
+$ go run line-filters.go
 HELLO
 FILTER
+words: ["the" "quick" "brown" "fox"]
+fields: ["a" "b" "" "c"]
+error: bufio.Scanner: token too long
+line length: 102400
+error: <nil>
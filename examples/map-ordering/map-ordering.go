@@ -0,0 +1,87 @@
+// 遍历 [map](maps) 时，键值对出现的顺序是不确定的。
+// 这不仅仅是“没有规定”：Go 的运行时会刻意地随机化遍历的顺序，
+// 即使是同一个 map，每次遍历的顺序也可能不同。
+// 这样，依赖于某种顺序的代码会尽早暴露出问题，
+// 而不是在换了一个 Go 版本或者 map 的大小变化之后才出错。
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// `order` 返回一次遍历中依次遇到的键。
+func order(m map[string]int) string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return strings.Join(keys, " ")
+}
+
+// `show` 按照 `keys` 的顺序打印 map 中的键值对。
+func show(m map[string]int, keys []string) {
+	for i, k := range keys {
+		if i > 0 {
+			fmt.Print(" ")
+		}
+		fmt.Print(k, "=", m[k])
+	}
+	fmt.Println()
+}
+
+func main() {
+
+	counts := map[string]int{
+		"apple": 3, "fig": 4, "kiwi": 2,
+		"lime": 1, "pear": 3, "plum": 2,
+	}
+
+	// 多遍历几次，通常很快就能看到与第一次不同的顺序。
+	// 具体的顺序每次运行都不一样，所以这里只打印是否发生了变化。
+	first := order(counts)
+	changed := false
+	for range 100 {
+		if order(counts) != first {
+			changed = true
+			break
+		}
+	}
+	fmt.Println("iteration order changed:", changed)
+	fmt.Println("order is unspecified, don't rely on it")
+
+	// 需要固定的顺序时，惯用的做法是先把键取出来放进切片，
+	// 对切片排序，再按照排好的顺序访问 map。
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	show(counts, keys)
+
+	// 从 Go 1.23 开始，也可以用 `maps.Keys` 得到键的迭代器，
+	// 再用 `slices.Sorted` 收集成排好序的切片，
+	// 参考 [slices 和 maps 包](slices-maps-packages)。
+	fmt.Println(slices.Sorted(maps.Keys(counts)))
+
+	// 按值排序时，同样先取出键，然后用比较函数比较它们对应的值。
+	// 值相同的键再按名称排序，否则它们之间的顺序
+	// 仍然取决于 map 的遍历顺序，输出就又不确定了。
+	byCount := slices.Collect(maps.Keys(counts))
+	slices.SortFunc(byCount, func(a, b string) int {
+		return cmp.Or(
+			cmp.Compare(counts[b], counts[a]),
+			cmp.Compare(a, b),
+		)
+	})
+	show(counts, byCount)
+
+	// `fmt` 打印 map 时会先按键排序，所以下面的输出总是相同的。
+	// 这只是为了方便调试和测试，并不代表遍历的顺序。
+	fmt.Println(counts)
+}
@@ -0,0 +1,8 @@
+# 遍历的顺序发生了变化，而排序之后的输出每次都相同。
+$ go run map-ordering.go
+iteration order changed: true
+order is unspecified, don't rely on it
+apple=3 fig=4 kiwi=2 lime=1 pear=3 plum=2
+[apple fig kiwi lime pear plum]
+fig=4 apple=3 pear=3 kiwi=2 plum=2 lime=1
+map[apple:3 fig:4 kiwi:2 lime:1 pear:3 plum:2]
@@ -0,0 +1,96 @@
+// 每个类型都有一个 _方法集_（method set），它决定了
+// 这个类型的值可以调用哪些方法、可以实现哪些[接口](interfaces)。
+// 对于类型 `T`，`T` 的方法集只包含值接收者的方法，
+// 而 `*T` 的方法集同时包含值接收者和指针接收者的方法。
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// `counter` 有一个值接收者的方法 `Value`，
+// 和一个指针接收者的方法 `Inc`，后者需要修改接收者。
+type counter struct {
+	n int
+}
+
+func (c counter) Value() int { return c.n }
+
+func (c *counter) Inc() { c.n++ }
+
+type valuer interface {
+	Value() int
+}
+
+type incrementer interface {
+	Inc()
+}
+
+// `methods` 列出类型 `t` 的方法集中所有导出的方法。
+func methods(t reflect.Type) []string {
+	var names []string
+	for i := range t.NumMethod() {
+		names = append(names, t.Method(i).Name)
+	}
+	return names
+}
+
+func main() {
+
+	// 通过反射可以看到两个方法集的区别。
+	var c counter
+	fmt.Println("counter: ", methods(reflect.TypeOf(c)))
+	fmt.Println("*counter:", methods(reflect.TypeOf(&c)))
+
+	// 尽管 `Inc` 不在 `counter` 的方法集中，
+	// 我们仍然可以在变量 `c` 上调用它：
+	// `c` 是 _可寻址的_，Go 会自动把 `c.Inc()` 变成 `(&c).Inc()`。
+	// 切片的元素，以及可寻址的结构体的字段，也都是可寻址的。
+	c.Inc()
+	counters := []counter{{}, {}}
+	counters[1].Inc()
+	fmt.Println("c:", c.Value(), "counters:", counters)
+
+	// `counter` 和 `*counter` 都实现了 `valuer`。
+	// 把 `c` 赋值给接口时保存的是它的一个副本，
+	// 之后对 `c` 的修改不会影响接口中的值。
+	var v valuer = c
+	var pv valuer = &c
+	c.Inc()
+	fmt.Println("copy:", v.Value(), "ptr:", pv.Value())
+
+	// 只有 `*counter` 实现了 `incrementer`。下面这行无法编译：
+	//
+	//	var i incrementer = c
+	//
+	// 编译器会报告：cannot use c (variable of struct type counter)
+	// as incrementer value in variable declaration: counter does not
+	// implement incrementer (method Inc has pointer receiver)
+	//
+	// 原因是接口中保存的值是不可寻址的：如果允许这样做，
+	// `Inc` 修改的只会是接口内部的副本，调用方完全看不到。
+	var i incrementer = &c
+	i.Inc()
+	fmt.Println("c:", c.Value())
+
+	// 同样，map 的元素也是不可寻址的，因为 map 增长时
+	// 元素会被移动。`m["a"].Inc()` 和 `v.(counter).Inc()`
+	// 都会得到编译错误 cannot call pointer method Inc on counter。
+	// 可以取出值、修改之后再写回去，或者在 map 中保存指针。
+	m := map[string]counter{"a": {}}
+	a := m["a"]
+	a.Inc()
+	m["a"] = a
+	pm := map[string]*counter{"a": {}}
+	pm["a"].Inc()
+	fmt.Println("m:", m["a"].n, "pm:", pm["a"].n)
+
+	// 类型断言得到的也只是一个不可寻址的副本。
+	// 想要在接口中的值上调用指针方法，接口里就应该保存指针。
+	// 检查一个值是否实现了接口时，要注意它的动态类型是 `T` 还是 `*T`。
+	_, ok := v.(incrementer)
+	_, pok := pv.(incrementer)
+	fmt.Println("incrementer:", ok, pok)
+}
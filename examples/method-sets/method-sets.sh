@@ -0,0 +1,10 @@
+# `counter` 的方法集中只有 `Value`，
+# 所以只有 `*counter` 类型的值实现了 `incrementer`。
+$ go run method-sets.go
+counter:  [Value]
+*counter: [Inc Value]
+c: 1 counters: [{0} {1}]
+copy: 1 ptr: 2
+c: 3
+m: 1 pm: 1
+incrementer: false true
@@ -0,0 +1,113 @@
+// 这个例子结合了[打点器](tickers)、[select](select)
+// 和 [context](context)，实现一个定期执行任务的小型调度器：
+// 它在每次打点时运行一次任务，并在被要求停止时干净地退出。
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// `runner` 定期运行一个任务，直到 `done` 被关闭
+// 或者 context 被取消。停止之后，
+// 它运行任务的次数会被发送到 `runs` 中。
+type runner struct {
+	done chan struct{}
+	once sync.Once
+	runs chan int
+}
+
+func newRunner() *runner {
+	return &runner{
+		done: make(chan struct{}),
+		runs: make(chan int, 1),
+	}
+}
+
+// `run` 每隔 `interval` 运行一次 `task`，
+// 直到 `Stop` 被调用或者 `ctx` 被取消。
+// 它会一直阻塞，所以通常在单独的协程中运行。
+func (r *runner) run(ctx context.Context, name string,
+	interval time.Duration, task func(n int)) {
+	// 退出时停止打点器，释放它占用的资源。
+	// 从 Go 1.23 开始，停止之后不需要再排空它的通道。
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	n := 0
+	for {
+		// 一个 `select` 同时等待下一次打点、`done`
+		// 和 `ctx.Done()`，哪一个先就绪就处理哪一个。
+		select {
+		case <-ticker.C:
+		case <-r.done:
+		case <-ctx.Done():
+		}
+
+		// 如果打点和停止的信号同时就绪，`select` 会随机选择一个。
+		// 所以在运行任务之前再检查一次，让停止优先于打点，
+		// 保证停止之后任务不会再多运行一次。
+		select {
+		case <-r.done:
+			fmt.Println(name, "stopped: done closed")
+		case <-ctx.Done():
+			fmt.Println(name, "stopped:", ctx.Err())
+		default:
+			n++
+			task(n)
+			continue
+		}
+		r.runs <- n
+		return
+	}
+}
+
+// `Stop` 关闭 `done`，让调度器停止。
+// 它可以被调用多次，也可以在任务中调用。
+func (r *runner) Stop() {
+	r.once.Do(func() { close(r.done) })
+}
+
+// `Wait` 等待调度器退出，并返回任务运行的次数。
+func (r *runner) Wait() int {
+	return <-r.runs
+}
+
+func main() {
+
+	// 任务在调度器的协程中运行，运行期间不会处理新的打点。
+	// 如果任务比打点的间隔还要慢，`Ticker` 会丢弃错过的打点，
+	// 而不是把它们积攒起来；但在任务运行期间，
+	// 调度器也无法响应 `done` 和 `ctx.Done()`。
+	// 所以任务应该尽快返回，不要在其中阻塞，
+	// 耗时较长的任务应该放在单独的协程中运行，
+	// 或者把工作交给[工作池](worker-pools)。
+	//
+	// 第一个任务在运行 3 次之后调用 `Stop`。
+	first := newRunner()
+	go first.run(context.Background(), "first",
+		20*time.Millisecond, func(n int) {
+			fmt.Println("run", n)
+			if n == 3 {
+				first.Stop()
+			}
+		})
+	fmt.Println("first runs:", first.Wait())
+
+	// 第二个任务在运行 2 次之后取消 context。
+	ctx, cancel := context.WithCancel(
+		context.Background())
+	defer cancel()
+	second := newRunner()
+	go second.run(ctx, "second", 20*time.Millisecond,
+		func(n int) {
+			fmt.Println("run", n)
+			if n == 2 {
+				cancel()
+			}
+		})
+	fmt.Println("second runs:", second.Wait())
+}
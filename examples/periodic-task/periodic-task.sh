@@ -0,0 +1,12 @@
+# 两个调度器分别运行了 3 次和 2 次，
+# 并报告了各自停止的原因。
+$ go run periodic-task.go
+run 1
+run 2
+run 3
+first stopped: done closed
+first runs: 3
+run 1
+run 2
+second stopped: context canceled
+second runs: 2
@@ -0,0 +1,133 @@
+// _管道（pipeline）_ 是由通道连接起来的一系列阶段，
+// 每个阶段都是一组协程：它们从上游的通道接收值，
+// 对这些值进行处理，再把结果发送到下游的通道。
+// 这个例子来自 Go 博客中的
+// [Go Concurrency Patterns: Pipelines and cancellation](https://go.dev/blog/pipelines)。
+
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+	"time"
+)
+
+// 第一个阶段 `gen` 把一组整数转换为一个通道。
+// 返回值的类型是只能接收的 `<-chan int`，
+// 调用者只能从中读取，向它发送或关闭它都无法通过编译。
+//
+// 关于关闭通道有一个简单的规则：由发送方关闭通道，
+// 并且只在不再发送任何值时关闭。接收方永远不应该关闭通道，
+// 因为它无法知道发送方是否还会发送，向已关闭的通道发送会引发 panic。
+// 每个阶段都创建并关闭自己的输出通道。
+//
+// 所有的阶段都接受一个 `done` 通道。`done` 被关闭时，
+// 各阶段会放弃发送并退出，即使下游已经不再接收，
+// 协程也不会阻塞在发送上，从而避免了[协程泄漏](goroutine-leaks)。
+func gen(done <-chan struct{}, nums ...int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for _, n := range nums {
+			select {
+			case out <- n:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// 第二个阶段 `sq` 从 `in` 接收整数，并发送它们的平方。
+// 在函数内部，`out` 是可以发送的双向通道，
+// 但返回给调用者时，它被转换为只能接收的类型。
+func sq(done <-chan struct{}, in <-chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for n := range in {
+			select {
+			case out <- n * n:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// 多个协程可以从同一个通道接收，以此分摊工作，
+// 这称为 _扇出（fan-out）_。`merge` 则是 _扇入（fan-in）_：
+// 它把多个通道合并为一个。每个输入通道由一个协程转发，
+// 由于有多个发送方，谁都不能单独关闭 `out`，
+// 所以我们用一个 `WaitGroup` 等待所有的转发协程结束，
+// 再由另外一个协程关闭 `out`。
+func merge(done <-chan struct{},
+	cs ...<-chan int) <-chan int {
+	var wg sync.WaitGroup
+	out := make(chan int)
+
+	output := func(c <-chan int) {
+		defer wg.Done()
+		for n := range c {
+			select {
+			case out <- n:
+			case <-done:
+				return
+			}
+		}
+	}
+	wg.Add(len(cs))
+	for _, c := range cs {
+		go output(c)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+func main() {
+
+	// 将各个阶段串联起来。`done` 在 `main` 返回时关闭，
+	// 无论管道中的工作是否已经完成，所有的协程都会退出。
+	done := make(chan struct{})
+	defer close(done)
+
+	// 只有一条流水线时，结果的顺序与输入相同。
+	for n := range sq(done, gen(done, 1, 2, 3, 4)) {
+		fmt.Println(n)
+	}
+
+	// 扇出：两个 `sq` 协程从同一个输入通道读取；
+	// 扇入：`merge` 合并它们的输出。结果的顺序取决于调度，
+	// 所以我们先收集所有的结果，排序后再打印。
+	in := gen(done, 5, 6, 7, 8)
+	var results []int
+	c1, c2 := sq(done, in), sq(done, in)
+	for n := range merge(done, c1, c2) {
+		results = append(results, n)
+	}
+	slices.Sort(results)
+	fmt.Println(results)
+
+	// 消费者也可以提前停止：这里我们只取第一个结果。
+	// 关闭 `stop` 通知所有上游阶段退出，此时仍阻塞在发送上的协程
+	// 会从 `<-done` 分支返回，而不会永远留在那里。
+	before := runtime.NumGoroutine()
+	stop := make(chan struct{})
+	src := gen(stop, 1, 2, 3, 4, 5, 6)
+	out := merge(stop, sq(stop, src), sq(stop, src))
+	<-out
+	close(stop)
+	fmt.Println("stopped after the first result")
+
+	// 稍等片刻，让协程有时间退出，再检查协程的数量。
+	time.Sleep(100 * time.Millisecond)
+	fmt.Println("leaked:", runtime.NumGoroutine()-before)
+}
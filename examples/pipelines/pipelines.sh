@@ -0,0 +1,10 @@
+# 单条流水线的结果保持了输入的顺序，扇出扇入的结果经过了排序。
+# 提前停止的消费者关闭 `done` 之后，没有协程泄漏。
+$ go run pipelines.go
+1
+4
+9
+16
+[25 36 49 64]
+stopped after the first result
+leaked: 0
@@ -0,0 +1,117 @@
+// `container/heap` 包为任何实现了 `heap.Interface`
+// 的类型提供堆操作。堆是实现优先级队列最常用的数据结构：
+// 插入和取出元素的时间复杂度都是 O(log n)。
+
+package main
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+// 队列中的每个元素都有一个值和一个优先级。
+// `index` 记录元素在堆中的位置，它由下面的方法维护，
+// 在调用 `heap.Fix` 更新某个元素时需要用到它。
+type item struct {
+	value    string
+	priority int
+	index    int
+}
+
+// `queue` 是一个元素指针的切片，它实现了 `heap.Interface`。
+type queue []*item
+
+// `Len`、`Less` 和 `Swap` 来自 `sort.Interface`。
+// 这里 `Less` 使用 `>`，因此优先级最高的元素排在最前面。
+func (q queue) Len() int { return len(q) }
+
+func (q queue) Less(i, j int) bool {
+	return q[i].priority > q[j].priority
+}
+
+func (q queue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+// `Push` 和 `Pop` 只负责在切片的*末尾*添加和删除元素，
+// 它们不需要关心堆的顺序。`heap.Push` 先调用 `Push`
+// 把新元素放在末尾，然后将它向上调整到正确的位置；
+// `heap.Pop` 先把堆顶的元素与最后一个元素交换，
+// 向下调整其余元素，再调用 `Pop` 取走末尾的那个元素。
+// 因为它们会修改切片的长度，所以使用指针接收者。
+// 注意不要直接调用这两个方法，而要通过 `heap` 包的函数。
+func (q *queue) Push(x any) {
+	it := x.(*item)
+	it.index = len(*q)
+	*q = append(*q, it)
+}
+
+func (q *queue) Pop() any {
+	old := *q
+	n := len(old)
+	it := old[n-1]
+	// 避免底层数组继续引用这个元素，以便它可以被垃圾回收。
+	old[n-1] = nil
+	it.index = -1
+	*q = old[:n-1]
+	return it
+}
+
+// `update` 修改一个元素的优先级，然后调用 `heap.Fix`
+// 根据它的 `index` 将它调整到新的位置。
+// 这比先删除再重新插入要高效。
+func (q *queue) update(it *item, priority int) {
+	it.priority = priority
+	heap.Fix(q, it.index)
+}
+
+func main() {
+
+	// 从一些固定的数据开始。
+	tasks := map[string]int{
+		"write docs": 2,
+		"fix bug":    5,
+		"review PR":  3,
+		"lunch":      1,
+	}
+
+	// 我们可以先以任意顺序填充切片，
+	// 然后调用一次 `heap.Init` 建立堆的顺序，
+	// 这只需要 O(n) 的时间。
+	q := make(queue, 0, len(tasks))
+	for value, priority := range tasks {
+		q = append(q, &item{
+			value:    value,
+			priority: priority,
+			index:    len(q),
+		})
+	}
+	heap.Init(&q)
+
+	// 之后使用 `heap.Push` 插入新的元素。
+	deploy := &item{value: "deploy", priority: 4}
+	heap.Push(&q, deploy)
+
+	// 堆的第一个元素总是优先级最高的那个，
+	// 查看它并不需要取出它。
+	// 其余元素只满足堆的性质，并不是完全有序的。
+	fmt.Println("top:", q[0].value)
+
+	// 事情有变：现在 `lunch` 成了最紧急的任务。
+	// 修改优先级后调用 `heap.Fix` 恢复堆的顺序。
+	for _, it := range q {
+		if it.value == "lunch" {
+			q.update(it, 6)
+		}
+	}
+	fmt.Println("top:", q[0].value)
+
+	// 最后使用 `heap.Pop` 依次取出所有元素，
+	// 它们按照优先级从高到低的顺序出队。
+	for q.Len() > 0 {
+		it := heap.Pop(&q).(*item)
+		fmt.Printf("%d: %s\n", it.priority, it.value)
+	}
+}
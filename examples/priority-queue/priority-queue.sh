@@ -0,0 +1,10 @@
+# 可以看到，修改优先级之后，`lunch` 立即出现在了堆顶，
+# 所有任务最终按照优先级从高到低的顺序出队。
+$ go run priority-queue.go
+top: fix bug
+top: lunch
+6: lunch
+5: fix bug
+4: deploy
+3: review PR
+2: write docs
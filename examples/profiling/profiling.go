@@ -0,0 +1,85 @@
+// norun
+
+// `runtime/pprof` 包可以把程序的运行情况写成 _profile_（性能剖析文件），
+// 然后用 `go tool pprof` 分析 CPU 时间花在了哪里、内存是在哪里分配的。
+// profile 的内容和运行的机器有关，每次都不相同。
+//
+// 对于一直运行的服务，更方便的做法是导入 `net/http/pprof`，
+// 它会在 `http.DefaultServeMux` 上注册 `/debug/pprof/` 下的 handler：
+//
+//	import _ "net/http/pprof"
+//
+//	go http.ListenAndServe("localhost:6060", nil)
+//
+// 然后就可以随时获取正在运行的程序的 profile，例如
+// `go tool pprof http://localhost:6060/debug/pprof/profile?seconds=30`。
+// 注意只应在内部地址上暴露这些 handler。
+// 另外，[基准测试](testing-and-benchmarking)也可以通过
+// `go test -bench . -cpuprofile cpu.prof` 直接生成 profile。
+
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+)
+
+// `work` 是一个受 CPU 限制的任务：反复计算哈希。
+func work(n int) [32]byte {
+	sum := sha256.Sum256([]byte("gobyexample"))
+	for range n {
+		sum = sha256.Sum256(sum[:])
+	}
+	return sum
+}
+
+// `allocate` 分配一些内存，并让它们一直被引用，
+// 这样它们才会出现在堆 profile 中。
+var retained [][]byte
+
+func allocate(n int) {
+	for range n {
+		retained = append(retained, make([]byte, 64<<10))
+	}
+}
+
+func main() {
+
+	// profile 被写入系统的临时目录中。
+	// 运行结束后文件会被保留下来，供之后分析。
+	cpuFile, err := os.CreateTemp("", "cpu-*.prof")
+	if err != nil {
+		panic(err)
+	}
+	defer cpuFile.Close()
+
+	// `StartCPUProfile` 开始对 CPU 进行采样（默认每秒 100 次），
+	// 直到调用 `StopCPUProfile`。只有在两者之间运行的代码
+	// 才会被记录下来，所以要保证工作负载运行足够长的时间。
+	if err := pprof.StartCPUProfile(cpuFile); err != nil {
+		panic(err)
+	}
+	sum := work(2_000_000)
+	pprof.StopCPUProfile()
+	fmt.Printf("sum: %x...\n", sum[:4])
+	fmt.Println("cpu profile:", cpuFile.Name())
+
+	// 堆 profile 记录的是内存分配的采样。
+	// 在写入之前调用 `runtime.GC`，
+	// 可以让其中的统计信息反映最新的存活对象。
+	allocate(100)
+	heapFile, err := os.CreateTemp("", "heap-*.prof")
+	if err != nil {
+		panic(err)
+	}
+	defer heapFile.Close()
+	runtime.GC()
+	err = pprof.WriteHeapProfile(heapFile)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("heap profile:", heapFile.Name())
+}
@@ -0,0 +1,31 @@
+# 运行程序，它会打印出 profile 文件的路径。
+# 临时文件的名字每次都不相同。
+$ go run profiling.go
+sum: 12f0281e...
+cpu profile: /tmp/cpu-3554476403.prof
+heap profile: /tmp/heap-1361262979.prof
+
+# 使用 `go tool pprof` 分析 CPU profile。
+# `-top` 按函数自身耗费的时间（flat）列出最耗时的函数，
+# cum 列则包含了它调用的其他函数所花的时间。
+$ go tool pprof -top /tmp/cpu-3554476403.prof
+Type: cpu
+Duration: 199.13ms, Total samples = 200ms (100.44%)
+      flat  flat%   sum%        cum   cum%
+     130ms 65.00% 65.00%      130ms 65.00%  crypto/...
+      20ms 10.00% 75.00%      190ms 95.00%  crypto/...
+...
+
+# 堆 profile 默认显示的是仍在使用的内存（inuse_space），
+# 使用 `-sample_index=alloc_space` 可以查看累计分配的内存。
+$ go tool pprof -top /tmp/heap-1361262979.prof
+Type: inuse_space
+      flat  flat%   sum%        cum   cum%
+    9.57MB   100%   100%     9.57MB   100%  main.allocate
+         0     0%   100%     9.57MB   100%  main.main
+...
+
+# 不加 `-top` 会进入交互模式，可以使用 `top`、`list 函数名`
+# 等命令。`-http=:8080` 则会在浏览器中打开图形界面，
+# 包括调用图和火焰图。
+$ go tool pprof -http=:8080 /tmp/cpu-3554476403.prof
@@ -1,3 +1,5 @@
+// norun
+
 // Go 的 `math/rand` 包提供了[伪随机数](http://en.wikipedia.org/wiki/Pseudorandom_number_generator)生成器。
 
 package main
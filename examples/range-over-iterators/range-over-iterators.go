@@ -0,0 +1,92 @@
+// 从 1.23 版本开始，Go 支持使用 `range` 遍历 *迭代器（iterator）*。
+// 迭代器是一个函数，它把每个元素依次传给一个 `yield` 函数。
+
+package main
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// `fib` 返回一个 `iter.Seq[int]`，即 `func(yield func(int) bool)`。
+// 它依次产生不超过 `n` 的斐波那契数。
+// 当 `yield` 返回 `false` 时，说明调用者已经不需要更多的值了，
+// 迭代器必须立即停止。
+func fib(n int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		a, b := 0, 1
+		for a <= n {
+			if !yield(a) {
+				fmt.Println("fib: stopped early")
+				return
+			}
+			a, b = b, a+b
+		}
+	}
+}
+
+// `iter.Seq2[K, V]` 每次产生一对值，
+// 可以用 `for k, v := range` 的形式遍历。
+func enumerate(words []string) iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		for i, w := range words {
+			if !yield(i, w) {
+				return
+			}
+		}
+	}
+}
+
+// 自定义的集合类型通常会提供一个返回迭代器的 `All` 方法，
+// 这样调用者就不必关心集合的内部结构。
+type Playlist struct {
+	songs []string
+}
+
+func (p *Playlist) Add(song string) {
+	p.songs = append(p.songs, song)
+}
+
+func (p *Playlist) All() iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		for i, s := range p.songs {
+			if !yield(i+1, s) {
+				return
+			}
+		}
+	}
+}
+
+func main() {
+
+	// 使用 `range` 遍历迭代器，就像遍历切片一样。
+	for v := range fib(5) {
+		fmt.Println(v)
+	}
+
+	// 在循环中使用 `break` 时，`yield` 会返回 `false`，
+	// 迭代器在这里停止，不会再产生后面的值。
+	for v := range fib(1000) {
+		if v > 1 {
+			break
+		}
+		fmt.Println(v)
+	}
+
+	// 标准库中的一些函数也接受迭代器，
+	// 例如 `slices.Collect` 将迭代器产生的所有值收集到一个切片中。
+	fmt.Println(slices.Collect(fib(20)))
+
+	for i, s := range enumerate([]string{"a", "b", "c"}) {
+		fmt.Println(i, s)
+	}
+
+	var p Playlist
+	p.Add("Yesterday")
+	p.Add("Imagine")
+	p.Add("Hey Jude")
+	for n, song := range p.All() {
+		fmt.Printf("%d. %s\n", n, song)
+	}
+}
@@ -0,0 +1,18 @@
+$ go run range-over-iterators.go
+0
+1
+1
+2
+3
+5
+0
+1
+1
+fib: stopped early
+[0 1 1 2 3 5 8 13]
+0 a
+1 b
+2 c
+1. Yesterday
+2. Imagine
+3. Hey Jude
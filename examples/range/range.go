@@ -1,3 +1,5 @@
+// norun
+
 // _range_ 用于迭代各种各样的数据结构。
 // 让我们来看看如何在我们已经学过的数据结构上使用 `range`。
 
@@ -5,10 +5,23 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
+// `waited` 报告从 `start` 开始是否经过了明显的等待。
+// 为了让输出固定，我们不打印具体的时间，
+// 只区分请求是立即被处理，还是等待了下一个时间间隔。
+func waited(start time.Time) string {
+	if time.Since(start) < 50*time.Millisecond {
+		return "immediately"
+	}
+	return "after waiting"
+}
+
 func main() {
 
 	// 首先，我们将看一个基本的速率限制。
@@ -19,15 +32,17 @@ func main() {
 	}
 	close(requests)
 
-	// `limiter` 通道每 200ms 接收一个值。
+	// `limiter` 每 100ms 接收一个值。
 	// 这是我们任务速率限制的调度器。
-	limiter := time.Tick(200 * time.Millisecond)
+	limiter := time.NewTicker(100 * time.Millisecond)
+	defer limiter.Stop()
 
 	// 通过在每次请求前阻塞 `limiter` 通道的一个接收，
-	// 可以将频率限制为，每 200ms 执行一次请求。
+	// 可以将频率限制为，每 100ms 执行一次请求。
 	for req := range requests {
-		<-limiter
-		fmt.Println("request", req, time.Now())
+		start := time.Now()
+		<-limiter.C
+		fmt.Println("request", req, waited(start))
 	}
 
 	// 有时候我们可能希望在速率限制方案中允许短暂的并发请求，并同时保留总体速率限制。
@@ -40,10 +55,12 @@ func main() {
 		burstyLimiter <- time.Now()
 	}
 
-	// 每 200ms 我们将尝试添加一个新的值到 `burstyLimiter`中，
+	// 每 100ms 我们将尝试添加一个新的值到 `burstyLimiter`中，
 	// 直到达到 3 个的限制。
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
 	go func() {
-		for t := range time.Tick(200 * time.Millisecond) {
+		for t := range ticker.C {
 			burstyLimiter <- t
 		}
 	}()
@@ -55,8 +72,47 @@ func main() {
 		burstyRequests <- i
 	}
 	close(burstyRequests)
+	fmt.Println()
 	for req := range burstyRequests {
+		start := time.Now()
 		<-burstyLimiter
-		fmt.Println("request", req, time.Now())
+		fmt.Println("request", req, waited(start))
+	}
+
+	// 上面的做法需要一个一直运行的协程和打点器，
+	// 而且打点器不关心是否有请求：空闲时它照样在“滴答”，
+	// 繁忙时多出来的值只能被丢弃在阻塞的发送上。
+	// 扩展库 `golang.org/x/time/rate` 提供的 `rate.Limiter`
+	// 实现了[令牌桶](https://en.wikipedia.org/wiki/Token_bucket)算法：
+	// 令牌以固定的速率放入容量为 burst 的桶中，每个请求消耗一个令牌。
+	// 它在需要时才根据流逝的时间计算令牌的数量，
+	// 不需要后台协程，也可以安全地被多个协程共享。
+	// 这里我们每 100ms 补充一个令牌，桶里最多存放 3 个。
+	every := rate.Every(100 * time.Millisecond)
+	lim := rate.NewLimiter(every, 3)
+
+	// `Allow` 不会阻塞，它只是报告此刻是否有可用的令牌。
+	// 桶一开始是满的，所以前 3 次调用成功，之后的请求被拒绝，
+	// 适合“超过速率就直接丢弃”的场景。
+	fmt.Println()
+	for i := 1; i <= 5; i++ {
+		fmt.Println("allow", i, lim.Allow())
+	}
+
+	// `Wait` 则会阻塞，直到有可用的令牌，或者 context 被取消。
+	ctx := context.Background()
+	for i := 1; i <= 2; i++ {
+		start := time.Now()
+		if err := lim.Wait(ctx); err != nil {
+			panic(err)
+		}
+		fmt.Println("wait", i, waited(start))
 	}
+
+	// 如果在 context 的截止时间之前不可能拿到令牌，
+	// `Wait` 会立即返回一个错误，而不是白白等待。
+	ctx, cancel := context.WithTimeout(
+		ctx, 10*time.Millisecond)
+	defer cancel()
+	fmt.Println(lim.Wait(ctx))
 }
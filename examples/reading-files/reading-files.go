@@ -5,66 +5,150 @@ package main
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
 )
 
-// 读取文件需要经常进行错误检查，
-// 这个帮助方法可以精简下面的错误检查过程。
-func check(e error) {
-	if e != nil {
-		panic(e)
+func main() {
+
+	// 读取文件需要经常进行错误检查。与其在每一步都 `panic`，
+	// 更常见的做法是让函数返回错误，由调用者决定如何处理。
+	// 这里我们只是打印错误并以非零状态码退出。
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
 	}
 }
 
-func main() {
+func run() error {
+
+	// 为了让例子可以直接运行，我们先在系统的临时目录中
+	// 创建一个目录，并写入要读取的文件。
+	// 使用 `defer` 确保函数返回前删除它。
+	dir, err := os.MkdirTemp("", "reading-files")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "dat")
+	err = os.WriteFile(path, []byte("hello\ngo\n"), 0644)
+	if err != nil {
+		return err
+	}
+	if err := readFile(path); err != nil {
+		return err
+	}
+
+	// 打开不存在的文件时，返回的错误包装了 `fs.ErrNotExist`，
+	// 可以用 `errors.Is` 来判断。
+	_, err = os.ReadFile(filepath.Join(dir, "missing"))
+	fmt.Println("not exist:",
+		errors.Is(err, fs.ErrNotExist))
+	return nil
+}
+
+func readFile(path string) error {
 
 	// 最基本的文件读取任务或许就是将文件内容读取到内存中。
-	dat, err := os.ReadFile("/tmp/dat")
-	check(err)
+	dat, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
 	fmt.Print(string(dat))
 
 	// 您通常会希望对文件的读取方式和内容进行更多控制。
 	// 对于这个任务，首先使用 `Open` 打开一个文件，以获取一个 `os.File` 值。
-	f, err := os.Open("/tmp/dat")
-	check(err)
+	// 打开成功后，立即使用 `defer` 关闭它。
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
 
 	// 从文件的开始位置读取一些字节。
 	// 最多允许读取 5 个字节，但还要注意实际读取了多少个。
 	b1 := make([]byte, 5)
 	n1, err := f.Read(b1)
-	check(err)
-	fmt.Printf("%d bytes: %s\n", n1, string(b1[:n1]))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d bytes: %s\n", n1, b1[:n1])
 
 	// 你也可以 `Seek` 到一个文件中已知的位置，并从这个位置开始读取。
-	o2, err := f.Seek(6, 0)
-	check(err)
+	// 第二个参数决定偏移量的含义：`io.SeekStart` 相对于文件开头，
+	// `io.SeekCurrent` 相对于当前位置，`io.SeekEnd` 相对于文件末尾。
+	o2, err := f.Seek(6, io.SeekStart)
+	if err != nil {
+		return err
+	}
 	b2 := make([]byte, 2)
 	n2, err := f.Read(b2)
-	check(err)
-	fmt.Printf("%d bytes @ %d: ", n2, o2)
-	fmt.Printf("%v\n", string(b2[:n2]))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d bytes @ %d: %s\n", n2, o2, b2[:n2])
 
-	// 例如，`io` 包提供了一个更健壮的实现 `ReadAtLeast`，用于读取上面那种文件。
-	o3, err := f.Seek(6, 0)
-	check(err)
+	// `Read` 读取的字节可能比要求的少。
+	// `io.ReadFull` 则保证读满整个切片，否则返回错误。
+	// 这里我们从距离文件末尾 3 个字节的位置开始读取。
+	o3, err := f.Seek(-3, io.SeekEnd)
+	if err != nil {
+		return err
+	}
 	b3 := make([]byte, 2)
-	n3, err := io.ReadAtLeast(f, b3, 2)
-	check(err)
-	fmt.Printf("%d bytes @ %d: %s\n", n3, o3, string(b3))
-
-	// 没有内建的倒带，但是 `Seek(0, 0)` 实现了这一功能。
-	_, err = f.Seek(0, 0)
-	check(err)
-
-	//  `bufio` 包实现了一个缓冲读取器，这可能有助于提高许多小读操作的效率，以及它提供了很多附加的读取函数。
-	r4 := bufio.NewReader(f)
-	b4, err := r4.Peek(5)
-	check(err)
-	fmt.Printf("5 bytes: %s\n", string(b4))
-
-	// 任务结束后要关闭这个文件
-	// （通常这个操作应该在 `Open` 操作后立即使用 `defer` 来完成）。
-	f.Close()
+	n3, err := io.ReadFull(f, b3)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d bytes @ %d: %s\n", n3, o3, b3)
+
+	// 如果剩余的内容不够，`io.ReadFull` 返回
+	// `io.ErrUnexpectedEOF`，而不是悄悄地只读取一部分。
+	_, err = io.ReadFull(f, make([]byte, 4))
+	fmt.Println("read full:", err)
+
+	// 没有内建的倒带，但是 `Seek(0, io.SeekStart)` 实现了这一功能。
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	// `bufio` 包实现了一个缓冲读取器，这可能有助于提高许多小读操作的效率，
+	// 以及它提供了很多附加的读取函数。
+	// `Peek` 可以查看接下来的字节，而不会消耗它们。
+	r := bufio.NewReader(f)
+	b4, err := r.Peek(5)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("peek: %s\n", b4)
+
+	// 所以接下来的 `Read` 仍然从文件开头读取。
+	b5 := make([]byte, 3)
+	n5, err := r.Read(b5)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d bytes: %s\n", n5, b5[:n5])
+
+	// `ReadString` 一直读取到指定的分隔符为止，返回的字符串包含分隔符。
+	// 读到文件末尾时，它返回已经读到的内容以及 `io.EOF`，
+	// 这是表示读取结束的正常信号，而不是真正的错误。
+	for {
+		line, err := r.ReadString('\n')
+		if len(line) > 0 {
+			fmt.Printf("line: %q\n", line)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
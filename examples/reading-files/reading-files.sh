@@ -1,11 +1,16 @@
-$ echo "hello" > /tmp/dat
-$ echo "go" >>   /tmp/dat
+# 运行这个程序。它会先在临时目录中写入一个包含
+# `hello` 和 `go` 两行内容的文件，然后再读取它。
 $ go run reading-files.go
 hello
 go
 5 bytes: hello
 2 bytes @ 6: go
 2 bytes @ 6: go
-5 bytes: hello
+read full: unexpected EOF
+peek: hello
+3 bytes: hel
+line: "lo\n"
+line: "go\n"
+not exist: true
 
 # 下面我们来看一下写入文件。
@@ -7,14 +7,83 @@
 
 package main
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
 
 // 这是一个 panic 函数
 func mayPanic() {
 	panic("a problem")
 }
 
+// `safeCall` 调用 `f`，并将其中发生的 panic 转换为一个错误返回。
+// 这里的关键是 *命名返回值* `err`：defer 函数在 `safeCall`
+// 返回之前运行，它对 `err` 的修改就是调用者得到的返回值。
+func safeCall(f func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered: %v", r)
+		}
+	}()
+	f()
+	return nil
+}
+
+// 发生 panic 时，当前函数中已经 defer 的函数仍然会执行，
+// 并且与正常返回时一样，按照后进先出（LIFO）的顺序执行。
+func cleanup() {
+	defer fmt.Println("deferred 1")
+	defer fmt.Println("deferred 2")
+	panic("cleanup failed")
+}
+
+var errExpected = errors.New("expected failure")
+
+// `recover` 会捕获所有的 panic。如果只想处理特定的 panic，
+// 可以检查 `recover` 的返回值，对于无法处理的值，
+// 再次调用 `panic`，让它继续向上传播。
+func handleExpected(f func()) {
+	defer func() {
+		r := recover()
+		if r == errExpected {
+			fmt.Println("handled:", r)
+			return
+		}
+		if r != nil {
+			panic(r)
+		}
+	}()
+	f()
+}
+
 func main() {
+	fmt.Println(safeCall(cleanup))
+
+	// 运行时错误，例如除以零或者数组越界，也会引发 panic。
+	fmt.Println(safeCall(func() {
+		a, b := 1, 0
+		fmt.Println(a / b)
+	}))
+
+	handleExpected(func() { panic(errExpected) })
+	fmt.Println(safeCall(func() {
+		handleExpected(func() { panic(42) })
+	}))
+
+	// `recover` 只能捕获当前协程中的 panic。
+	// 如果一个新启动的协程发生了 panic 而没有自己恢复，
+	// 即使 `main` 中使用了 `recover`，整个程序也会崩溃。
+	// 因此，每个可能 panic 的协程都需要自己调用 `recover`。
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		fmt.Println("goroutine:", safeCall(mayPanic))
+	}()
+	wg.Wait()
+
 	// 必须在 defer 函数中调用 `recover`。
 	// 当跳出引发 panic 的函数时，defer 会被激活，
 	// 其中的 `recover` 会捕获 `panic`。
@@ -1,2 +1,10 @@
+$ go run recover.go
+deferred 2
+deferred 1
+recovered: cleanup failed
+recovered: runtime error: integer divide by zero
+handled: expected failure
+recovered: 42
+goroutine: recovered: a problem
 Recovered. Error:
  a problem
@@ -0,0 +1,71 @@
+// *反射（reflection）* 让程序在运行时检查变量的类型和值，
+// 甚至修改它们。Go 通过 `reflect` 包提供反射的能力，
+// `encoding/json` 等标准库就是依靠它来处理任意类型的。
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// 结构体字段后面的字符串是 *标签（tag）*，
+// 它们对程序本身没有影响，但可以通过反射读取。
+type user struct {
+	Name  string `json:"name"`
+	Age   int    `json:"age,omitempty"`
+	Email string `json:"-"`
+}
+
+// 只有导出的方法才能通过反射调用。
+func (u user) Greet(greeting string) string {
+	return greeting + ", " + u.Name
+}
+
+func main() {
+	u := user{Name: "gopher", Age: 13, Email: "g@go.dev"}
+
+	// `reflect.TypeOf` 返回值的类型，`reflect.ValueOf`
+	// 返回一个包装了值本身的 `reflect.Value`。
+	t := reflect.TypeOf(u)
+	v := reflect.ValueOf(u)
+	fmt.Println("type:", t, "kind:", t.Kind())
+
+	// 对于结构体类型，可以通过 `NumField` 和 `Field`
+	// 遍历它的字段，得到每个字段的名称、类型和标签。
+	// `Tag.Get` 返回标签中指定 key 对应的值。
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		name, opts, _ := strings.Cut(tag, ",")
+		fmt.Printf("%s %s json=%q opts=%q value=%v\n",
+			f.Name, f.Type, name, opts,
+			v.Field(i).Interface())
+	}
+
+	// `v` 包装的是 `u` 的一个副本，因此不能通过它修改 `u`，
+	// `CanSet` 会返回 `false`。
+	fmt.Println("settable:", v.Field(0).CanSet())
+
+	// 想要修改原来的变量，需要传入它的指针，
+	// 再通过 `Elem` 取得指针指向的值。
+	pv := reflect.ValueOf(&u).Elem()
+	name := pv.FieldByName("Name")
+	fmt.Println("settable:", name.CanSet())
+	name.SetString("gopher2")
+	pv.FieldByName("Age").SetInt(14)
+	fmt.Printf("%+v\n", u)
+
+	// `MethodByName` 按名称查找方法，`Call` 以
+	// `[]reflect.Value` 的形式传入参数并返回结果。
+	m := reflect.ValueOf(u).MethodByName("Greet")
+	args := []reflect.Value{reflect.ValueOf("hello")}
+	out := m.Call(args)
+	fmt.Println(out[0].String())
+
+	// 注意，反射的代价并不小：它比直接访问慢得多，
+	// 并且把本该在编译时发现的类型错误推迟到了运行时，
+	// 例如对 `int` 字段调用 `SetString` 会引发 panic。
+	// 因此，只在确实需要处理任意类型时才使用反射。
+}
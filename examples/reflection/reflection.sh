@@ -0,0 +1,9 @@
+$ go run reflection.go
+type: main.user kind: struct
+Name string json="name" opts="" value=gopher
+Age int json="age" opts="omitempty" value=13
+Email string json="-" opts="" value=g@go.dev
+settable: false
+settable: true
+{Name:gopher2 Age:14 Email:g@go.dev}
+hello, gopher2
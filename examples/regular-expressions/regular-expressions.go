@@ -7,8 +7,16 @@ import (
 	"bytes"
 	"fmt"
 	"regexp"
+	"strings"
 )
 
+// 正则表达式通常在包级别的变量中使用 `MustCompile` 编译：
+// 程序启动时只编译一次，表达式写错时会立即 panic，
+// 而不会等到第一次使用时才发现。
+// `(?P<name>...)` 定义了一个 *命名分组*。
+var datePat = regexp.MustCompile(
+	`(?P<year>\d{4})-(?P<month>\d{2})-(?P<day>\d{2})`)
+
 func main() {
 
 	// 测试一个字符串是否符合一个表达式。
@@ -64,4 +72,40 @@ func main() {
 	in := []byte("a peach")
 	out := r.ReplaceAllFunc(in, bytes.ToUpper)
 	fmt.Println(string(out))
+
+	// `ReplaceAllStringFunc` 是它的字符串版本。
+	fmt.Println(r.ReplaceAllStringFunc("a peach punch",
+		func(s string) string {
+			return "[" + strings.ToUpper(s) + "]"
+		}))
+
+	// `SubexpNames` 返回每个分组的名称，与 `FindStringSubmatch`
+	// 返回的切片一一对应，第 0 项是整个匹配，名称为空。
+	m := datePat.FindStringSubmatch("released 2012-03-28")
+	for i, name := range datePat.SubexpNames() {
+		if name != "" {
+			fmt.Printf("%s=%s\n", name, m[i])
+		}
+	}
+
+	// 也可以通过 `SubexpIndex` 按名称找到分组的位置。
+	fmt.Println("year:", m[datePat.SubexpIndex("year")])
+
+	// 在替换字符串中，可以使用 `${name}` 引用命名分组。
+	fmt.Println(datePat.ReplaceAllString("2012-03-28",
+		"${day}/${month}/${year}"))
+
+	// 注意，各种 `Index` 函数返回的是 *字节* 的位置，
+	// 而不是字符的位置，对于多字节的 UTF-8 文本，二者并不相同。
+	// 需要匹配的内容时，直接使用返回字符串的版本更简单。
+	s := "桃子 peach"
+	fmt.Println("idx:", r.FindStringIndex(s))
+	fmt.Println(r.FindString(s))
+
+	// 默认情况下，只要字符串中的 *某一部分* 匹配，
+	// `MatchString` 就会返回 `true`。
+	// 想要匹配整个字符串，需要使用 `^` 和 `$` 锚定表达式。
+	fmt.Println(r.MatchString("peaches"))
+	anchored := regexp.MustCompile("^p([a-z]+)ch$")
+	fmt.Println(anchored.MatchString("peaches"))
 }
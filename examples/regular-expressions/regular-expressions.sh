@@ -12,5 +12,15 @@ true
 regexp: p([a-z]+)ch
 a <fruit>
 a PEACH
+a [PEACH] [PUNCH]
+year=2012
+month=03
+day=28
+year: 2012
+28/03/2012
+idx: [7 12]
+peach
+true
+false
 
 # 有关 Go 正则表达式的说明，请参考 [`regexp`](http://golang.org/pkg/regexp/) 包文档。
@@ -0,0 +1,69 @@
+// norun
+
+// `runtime` 包提供了与 Go 运行时交互的函数，
+// 可以查看调度器、协程以及内存分配的状态。
+// 这些信息与运行的机器和时机有关，所以每次运行的输出都可能不同。
+
+package main
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func main() {
+
+	// `runtime.Version` 返回编译这个程序的 Go 版本，
+	// `runtime.GOOS` 和 `runtime.GOARCH` 是目标平台。
+	fmt.Println("version:", runtime.Version())
+	fmt.Println("platform:", runtime.GOOS, runtime.GOARCH)
+
+	// `NumCPU` 是当前进程可以使用的逻辑 CPU 的数量。
+	// `GOMAXPROCS` 决定了最多有多少个线程同时执行 Go 代码，
+	// 传入 0 只查询而不修改它。它的默认值是 CPU 的数量，
+	// 从 Go 1.25 开始，在容器中还会考虑 cgroup 的 CPU 限制。
+	// 也可以通过环境变量 `GOMAXPROCS` 设置它。
+	// 通常不需要调整它，除非程序运行在 CPU 配额远小于
+	// 机器核数的环境中，或者想要刻意限制程序占用的 CPU。
+	fmt.Println("cpus:", runtime.NumCPU())
+	fmt.Println("gomaxprocs:", runtime.GOMAXPROCS(0))
+
+	// `NumGoroutine` 返回当前存在的协程数量。
+	done := make(chan bool)
+	for range 10 {
+		go func() { <-done }()
+	}
+	fmt.Println("goroutines:", runtime.NumGoroutine())
+	close(done)
+
+	// `Gosched` 让出处理器，使其他协程有机会运行，
+	// 当前协程稍后会自动恢复执行。在实际的程序中很少需要它，
+	// 调度器会自动在协程之间切换。
+	runtime.Gosched()
+
+	// `ReadMemStats` 读取内存分配器的统计信息，
+	// 例如 `Mallocs` 是累计分配的对象数量，`TotalAlloc`
+	// 是累计分配的字节数，`HeapAlloc` 是堆上仍在使用的字节数，
+	// `NumGC` 是已经完成的垃圾回收的次数。
+	// 注意 `ReadMemStats` 会短暂地暂停整个程序，不要频繁地调用它。
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	// 分配一个 64MB 的切片，再看看统计信息的变化。
+	data := make([]byte, 64<<20)
+	data[0] = 1
+	runtime.ReadMemStats(&after)
+	fmt.Println("mallocs:", after.Mallocs-before.Mallocs)
+	fmt.Println("allocated MB:",
+		(after.TotalAlloc-before.TotalAlloc)>>20)
+	fmt.Println("heap MB:", after.HeapAlloc>>20)
+
+	// `runtime.GC` 立即执行一次垃圾回收，并且阻塞直到完成。
+	// 切片不再被使用之后，它占用的内存就会被回收。
+	// 同样地，一般应该让运行时自己决定何时回收。
+	data = nil
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	fmt.Println("heap MB:", after.HeapAlloc>>20)
+	fmt.Println("gc runs:", after.NumGC-before.NumGC)
+}
@@ -0,0 +1,21 @@
+# 运行程序。版本、CPU 数量以及内存的统计信息
+# 都取决于运行的机器和环境，你看到的数字可能不同。
+$ go run runtime-stats.go
+version: go1.25.0
+platform: linux amd64
+cpus: 8
+gomaxprocs: 8
+goroutines: 11
+mallocs: 4
+allocated MB: 64
+heap MB: 64
+heap MB: 0
+gc runs: 2
+
+# 通过环境变量限制 `GOMAXPROCS`。
+$ GOMAXPROCS=2 go run runtime-stats.go
+version: go1.25.0
+platform: linux amd64
+cpus: 8
+gomaxprocs: 2
+...
@@ -0,0 +1,108 @@
+// [互斥锁](mutexes) 每次只允许一个协程访问数据。
+// 但很多数据大部分时间都只是被读取，多个读取者同时访问
+// 并不会互相影响。`sync.RWMutex` 是一把 _读写锁_：
+// 它允许多个读取者同时持有锁，而写入者则独占这把锁。
+
+package main
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// `store` 使用读写锁保护一个 map。除了数据本身，
+// 它还统计了读写的次数，以及当前正在读取的协程数。
+type store struct {
+	mu   sync.RWMutex
+	data map[string]int
+
+	reads, writes atomic.Int64
+	readers       atomic.Int64
+	exclusive     atomic.Bool
+}
+
+// 读取时使用 `RLock` 和 `RUnlock`，
+// 任意多个协程可以同时持有读锁。
+func (s *store) get(key string) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.readers.Add(1)
+	defer s.readers.Add(-1)
+	s.reads.Add(1)
+	return s.data[key]
+}
+
+// 写入时使用 `Lock` 和 `Unlock`。持有写锁时，
+// 其他的读取者和写入者都必须等待，所以这里
+// 不应该有任何正在读取的协程。
+func (s *store) inc(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.readers.Load() != 0 {
+		s.exclusive.Store(false)
+	}
+	s.writes.Add(1)
+	s.data[key]++
+}
+
+func main() {
+	s := &store{data: map[string]int{}}
+	s.exclusive.Store(true)
+	var wg sync.WaitGroup
+
+	// 首先证明多个读取者确实可以同时持有读锁：
+	// 每个读取者拿到读锁之后，都等待其他所有读取者
+	// 也拿到读锁，然后才释放。如果这里使用的是 `Mutex`，
+	// 第二个读取者就永远拿不到锁，程序会死锁。
+	const readers = 5
+	var inside sync.WaitGroup
+	inside.Add(readers)
+	for range readers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.mu.RLock()
+			defer s.mu.RUnlock()
+			inside.Done()
+			inside.Wait()
+		}()
+	}
+	wg.Wait()
+	fmt.Println("concurrent readers:", readers)
+
+	// 接下来，8 个读取者和 2 个写入者同时访问 `store`，
+	// 每个协程执行固定次数的操作。
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				s.get("hits")
+			}
+		}()
+	}
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				s.inc("hits")
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 无论协程如何交替执行，最终的结果都是确定的。
+	fmt.Println("hits:", s.data["hits"])
+	fmt.Println("reads:", s.reads.Load(),
+		"writes:", s.writes.Load())
+	fmt.Println("exclusive writes:", s.exclusive.Load())
+
+	// `RWMutex` 并不总是比 `Mutex` 更快：它需要维护更多的状态，
+	// 每次加锁和解锁的开销也更大。只有在读操作远多于写操作，
+	// 并且持有读锁的时间足够长时，它才会有明显的优势；
+	// 对于写操作频繁或临界区很短的情况，普通的 `Mutex`
+	// 通常更简单也更快。如果不确定，可以编写
+	// [基准测试](testing-and-benchmarking) 进行比较。
+}
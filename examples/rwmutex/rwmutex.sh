@@ -0,0 +1,5 @@
+$ go run rwmutex.go
+concurrent readers: 5
+hits: 100
+reads: 800 writes: 100
+exclusive writes: true
@@ -0,0 +1,121 @@
+// 在 [通道选择器](select)、[超时处理](timeouts) 和
+// [非阻塞通道操作](non-blocking-channel-operations) 中，
+// 我们已经分别见过 `select` 的几种用法。
+// 这里把几种最常见的模式放在一起，并看看使用时需要注意的地方。
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+func main() {
+
+	// *超时*：`time.After` 返回一个通道，它会在给定的时间后
+	// 收到一个值。把它作为 `select` 的一个分支，
+	// 就可以限制等待其他通道的时间。
+	slow := make(chan string, 1)
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		slow <- "result"
+	}()
+	select {
+	case res := <-slow:
+		fmt.Println(res)
+	case <-time.After(50 * time.Millisecond):
+		fmt.Println("timeout waiting for result")
+	}
+
+	// 在循环中要小心使用 `time.After`：每次执行 `select`
+	// 都会创建一个新的计时器。在 Go 1.23 之前，这些计时器
+	// 在触发之前不会被回收，紧凑的循环会因此积累大量内存。
+	// 更好的做法是创建一个 `time.Timer`，并在每次循环时
+	// 调用 `Reset` 复用它。这里，如果 100ms 内没有收到
+	// 新的值，就认为生产者已经空闲。
+	values := make(chan int)
+	go func() {
+		for i := 1; i <= 3; i++ {
+			values <- i
+		}
+	}()
+	idle := time.NewTimer(100 * time.Millisecond)
+	defer idle.Stop()
+loop:
+	for {
+		select {
+		case v := <-values:
+			fmt.Println("value", v)
+			idle.Reset(100 * time.Millisecond)
+		case <-idle.C:
+			fmt.Println("idle, stopping")
+			break loop
+		}
+	}
+
+	// *非阻塞操作*：带有 `default` 分支的 `select`
+	// 在其他分支都无法立即执行时，直接执行 `default`。
+	// 这里没有任何协程发送数据，所以接收不会成功。
+	messages := make(chan string)
+	select {
+	case msg := <-messages:
+		fmt.Println("received", msg)
+	default:
+		fmt.Println("no message received")
+	}
+
+	// 同样可以进行非阻塞的发送。缓冲区满了之后，
+	// 再发送的值会被丢弃，而不是阻塞发送者。
+	buffered := make(chan string, 1)
+	for _, msg := range []string{"first", "second"} {
+		select {
+		case buffered <- msg:
+			fmt.Println("sent", msg)
+		default:
+			fmt.Println("dropped", msg)
+		}
+	}
+
+	// *合并多个通道*：在循环中使用 `select`，从多个通道
+	// 接收数据，直到它们全部被关闭。
+	nums := make(chan int)
+	words := make(chan string)
+	go func() {
+		defer close(nums)
+		for i := 1; i <= 3; i++ {
+			nums <- i
+		}
+	}()
+	go func() {
+		defer close(words)
+		for _, w := range []string{"x", "y"} {
+			words <- w
+		}
+	}()
+
+	// 从已关闭的通道接收会立即返回零值，`ok` 为 `false`。
+	// 如果不做处理，`select` 会不停地选中这个分支，
+	// 造成忙等。将关闭的通道设置为 `nil` 可以避免这个问题：
+	// 对 `nil` 通道的操作永远阻塞，它的分支不会再被选中。
+	// 两个通道的数据交替到达的顺序并不固定，
+	// 所以这里分别收集，最后再打印。
+	var gotNums []int
+	var gotWords []string
+	for nums != nil || words != nil {
+		select {
+		case n, ok := <-nums:
+			if !ok {
+				nums = nil
+				continue
+			}
+			gotNums = append(gotNums, n)
+		case w, ok := <-words:
+			if !ok {
+				words = nil
+				continue
+			}
+			gotWords = append(gotWords, w)
+		}
+	}
+	fmt.Println("nums:", gotNums, "words:", gotWords)
+}
@@ -0,0 +1,10 @@
+$ go run select-patterns.go
+timeout waiting for result
+value 1
+value 2
+value 3
+idle, stopping
+no message received
+sent first
+dropped second
+nums: [1 2 3] words: [x y]
@@ -7,8 +7,13 @@ package main
 
 // Go 在多个 `crypto/*` 包中实现了一系列散列函数。
 import (
+	"crypto/hmac"
 	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
 	"fmt"
+	"hash/crc32"
+	"hash/fnv"
 )
 
 func main() {
@@ -19,6 +24,8 @@ func main() {
 
 	// 写入要处理的字节。如果是一个字符串，
 	// 需要使用 `[]byte(s)` 将其强制转换成字节数组。
+	// 所有的散列都实现了 `hash.Hash` 接口，它同时也是一个 `io.Writer`，
+	// 所以可以分多次写入数据，例如用 `io.Copy` 从文件中流式地写入。
 	h.Write([]byte(s))
 
 	// `Sum` 得到最终的散列值的字符切片。`Sum` 接收一个参数，
@@ -29,4 +36,50 @@ func main() {
 	// 我们这里也使用 `%x` 来将散列结果格式化为 16 进制字符串。
 	fmt.Println(s)
 	fmt.Printf("%x\n", bs)
+
+	// 如果数据已经全部在内存中，`sha256.Sum256` 可以一步得到散列值。
+	// 它返回一个 `[32]byte` 数组而不是切片。
+	// `hex.EncodeToString` 同样可以将它格式化为 16 进制字符串。
+	sum := sha256.Sum256([]byte(s))
+	hs := hex.EncodeToString(sum[:])
+	fmt.Println(hs == fmt.Sprintf("%x", bs))
+
+	// 其他的散列函数用法相同，例如 `crypto/sha512`。
+	// 这里只打印散列值的长度：SHA512 的散列值有 64 个字节。
+	// 标准库中的 `crypto/md5` 和 `crypto/sha1` 已经不再安全，
+	// 可以人为地构造出散列值相同的两段数据，
+	// 所以除了兼容旧的系统之外，不要在新的代码中使用它们。
+	h512 := sha512.New()
+	h512.Write([]byte(s))
+	fmt.Println("sha512:", len(h512.Sum(nil)), "bytes")
+
+	// [HMAC](https://en.wikipedia.org/wiki/HMAC)
+	// 使用一个密钥计算消息的散列值，用来验证消息没有被篡改，
+	// 并且来自持有密钥的一方。
+	// `hmac.New` 接收一个创建散列的函数和密钥。
+	key := []byte("secret key")
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("message"))
+	tag := mac.Sum(nil)
+	fmt.Printf("hmac: %x\n", tag)
+
+	// 接收方使用同样的密钥重新计算 HMAC，再与收到的值比较。
+	// 比较时一定要使用 `hmac.Equal`，它花费的时间与内容无关；
+	// 而 `bytes.Equal` 在遇到第一个不同的字节时就会返回，
+	// 攻击者可以通过测量响应时间逐个字节地猜出正确的 HMAC。
+	check := hmac.New(sha256.New, key)
+	check.Write([]byte("message"))
+	fmt.Println("valid:", hmac.Equal(tag, check.Sum(nil)))
+	check.Reset()
+	check.Write([]byte("tampered"))
+	fmt.Println("valid:", hmac.Equal(tag, check.Sum(nil)))
+
+	// 如果只是需要校验数据是否损坏，或者为哈希表计算键，
+	// 非加密的散列函数更快。标准库中的 `hash/crc32`
+	// 常用于校验和，`hash/fnv` 常用于哈希表。
+	// 它们都不能抵御刻意的攻击，不要把它们用于安全相关的场景。
+	fmt.Println("crc32:", crc32.ChecksumIEEE([]byte(s)))
+	f := fnv.New64a()
+	f.Write([]byte(s))
+	fmt.Printf("fnv64a: %x\n", f.Sum64())
 }
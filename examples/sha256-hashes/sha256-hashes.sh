@@ -2,11 +2,13 @@
 $ go run sha256-hashes.go
 sha256 this string
 1af1dfa857bf1d8814fe1af8983c18080019922e557f15a8a...
-
-
-# 你可以使用和上面相似的方式来计算其他形式的散列值。
-# 例如，计算 SHA512 散列，
-# 引入 `crypto/sha512` 并使用 `sha512.New()` 方法。
+true
+sha512: 64 bytes
+hmac: c431e4857a6b817f9eeaab86b00f78e210808e5a2b2ae1e...
+valid: true
+valid: false
+crc32: 214286237
+fnv64a: 40840e016a25205b
 
 
 # 注意，如果你需要密码学上的安全散列，你需要仔细的研究一下
@@ -1,21 +1,42 @@
+// noplay
+
 // 有时候，我们希望 Go 可以智能的处理 [Unix 信号](http://en.wikipedia.org/wiki/Unix_signal)。
 // 例如，我们希望当服务器接收到一个 `SIGTERM` 信号时，能够优雅退出，
 // 或者一个命令行工具在接收到一个 `SIGINT` 信号时停止处理输入信息。
-// 我们这里讲的就是在 Go 中如何使用通道来处理信号。
+// 我们这里讲的就是在 Go 中如何使用通道和 context 来处理信号。
 
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
+// 为了让这个例子无需手动操作就能运行结束，
+// `interruptSelf` 在等待一小段时间后，向当前进程发送
+// `os.Interrupt`，效果与在终端按下 `ctrl-C` 相同。
+// 注意，Windows 不支持通过 `Signal` 发送 `os.Interrupt`。
+func interruptSelf(delay time.Duration) {
+	time.Sleep(delay)
+	p, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		panic(err)
+	}
+	if err := p.Signal(os.Interrupt); err != nil {
+		panic(err)
+	}
+}
+
 func main() {
 
 	// Go 通过向一个通道发送 `os.Signal` 值来发送信号通知。
-	// 我们将创建一个通道来接收这些通知。请注意，这个通道应该被缓存。
+	// 我们将创建一个通道来接收这些通知。请注意，这个通道应该被缓存：
+	// `signal` 包发送通知时不会阻塞，如果信号到达时
+	// 没有人正在接收，无缓冲通道上的这个信号就会被丢弃。
 	sigs := make(chan os.Signal, 1)
 
 	// `signal.Notify` 注册给定的通道，用于接收特定信号。
@@ -30,14 +51,40 @@ func main() {
 		// 这个协程执行一个阻塞的信号接收操作。
 		// 当它接收到一个值时，它将打印这个值，然后通知程序可以退出。
 		sig := <-sigs
-		fmt.Println()
 		fmt.Println(sig)
 		done <- true
 	}()
 
-	// 	程序将在这里进行等待，直到它得到了期望的信号
-	// （也就是上面的协程发送的 `done` 值），然后退出。
+	// 程序将在这里进行等待，直到它得到了期望的信号
+	// （也就是上面的协程发送的 `done` 值）。
 	fmt.Println("awaiting signal")
+	go interruptSelf(100 * time.Millisecond)
 	<-done
+
+	// 不再需要接收信号时，调用 `signal.Stop` 取消注册。
+	// 否则 `signal` 包会继续向这个通道发送信号，
+	// 而这些信号也不会再触发默认的行为（例如终止程序）。
+	signal.Stop(sigs)
+
+	// `signal.NotifyContext` 把上面的模式封装了起来：
+	// 它返回的 context 会在收到任意一个给定的信号时被取消，
+	// 这样信号就可以像超时一样，通过 context 传递给
+	// 所有正在进行的工作。
+	ctx, stop := signal.NotifyContext(
+		context.Background(),
+		os.Interrupt, syscall.SIGTERM,
+	)
+
+	// `stop` 的作用与 `signal.Stop` 相同，用完之后要调用它。
+	defer stop()
+
+	go interruptSelf(100 * time.Millisecond)
+	fmt.Println("working")
+	select {
+	case <-ctx.Done():
+		fmt.Println("shutting down:", ctx.Err())
+	case <-time.After(10 * time.Second):
+		fmt.Println("timed out")
+	}
 	fmt.Println("exiting")
 }
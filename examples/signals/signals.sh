@@ -1,8 +1,10 @@
-# 当我们运行这个程序时，它将一直等待一个信号。
-# 通过 `ctrl-C`（终端显示为 `^C`），我们可以发送一个 `SIGINT` 信号，
-# 这会使程序打印 `interrupt` 然后退出。
+# 当我们运行这个程序时，它将等待一个信号。
+# 通常我们会通过 `ctrl-C`（终端显示为 `^C`）发送一个 `SIGINT` 信号，
+# 这里程序会自己发送这个信号：第一次使它打印 `interrupt`，
+# 第二次则取消了 context，然后程序退出。
 $ go run signals.go
 awaiting signal
-^C
 interrupt
+working
+shutting down: context canceled
 exiting
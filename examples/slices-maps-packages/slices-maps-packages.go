@@ -0,0 +1,73 @@
+// Go 1.21 在标准库中加入了泛型的 `slices` 和 `maps` 包，
+// 它们提供了处理切片和 map 的常用函数。
+// 这些函数最初是在 `golang.org/x/exp` 中试验的，
+// 现在已经不需要再依赖这个模块了。
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func main() {
+
+	// `slices.Sort` 对任何有序类型的切片进行原地排序。
+	s := []int{5, 2, 8, 1}
+	slices.Sort(s)
+	fmt.Println("sorted:", s)
+
+	// `Contains` 判断切片中是否包含某个值，
+	// `Index` 返回它第一次出现的位置，不存在时返回 -1。
+	fmt.Println("contains 8:", slices.Contains(s, 8))
+	fmt.Println("index of 5:", slices.Index(s, 5))
+	fmt.Println("index of 3:", slices.Index(s, 3))
+
+	// `Insert` 在给定的位置插入值，`Delete` 删除
+	// 区间 `[i, j)` 中的元素。它们都返回修改后的切片，
+	// 就像 `append` 一样，需要使用返回值。
+	s = slices.Insert(s, 1, 10, 11)
+	fmt.Println("inserted:", s)
+	s = slices.Delete(s, 0, 2)
+	fmt.Println("deleted:", s)
+
+	// `Equal` 判断两个切片的长度和元素是否都相同。
+	fmt.Println("equal:",
+		slices.Equal(s, []int{11, 2, 5, 8}))
+
+	// `SortFunc` 使用自定义的比较函数排序，比较函数
+	// 在 `a < b` 时返回负数，相等时返回 0，否则返回正数。
+	// `cmp.Compare` 可以方便地比较有序类型的值。
+	people := []person{
+		{"Alex", 30}, {"Bob", 25}, {"Cleo", 30},
+	}
+	slices.SortFunc(people, func(a, b person) int {
+		if c := cmp.Compare(b.age, a.age); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.name, b.name)
+	})
+	fmt.Println("people:", people)
+
+	// 从 Go 1.23 开始，`maps.Keys` 和 `maps.Values`
+	// 返回的是 [迭代器](range-over-iterators)，而不是切片。
+	// map 的遍历顺序是不确定的，为了固定输出，
+	// 使用 `slices.Sorted` 将迭代器收集为一个排好序的切片。
+	m := map[string]int{"b": 2, "a": 1, "c": 3}
+	fmt.Println("keys:", slices.Sorted(maps.Keys(m)))
+	fmt.Println("values:", slices.Sorted(maps.Values(m)))
+
+	// `maps.Clone` 返回 map 的一个浅拷贝，
+	// `maps.Equal` 判断两个 map 是否包含相同的键值对。
+	c := maps.Clone(m)
+	fmt.Println("equal:", maps.Equal(m, c))
+	c["d"] = 4
+	fmt.Println("equal:", maps.Equal(m, c))
+}
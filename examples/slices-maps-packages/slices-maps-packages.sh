@@ -0,0 +1,13 @@
+$ go run slices-maps-packages.go
+sorted: [1 2 5 8]
+contains 8: true
+index of 5: 2
+index of 3: -1
+inserted: [1 10 11 2 5 8]
+deleted: [11 2 5 8]
+equal: true
+people: [{Alex 30} {Cleo 30} {Bob 25}]
+keys: [a b c]
+values: [1 2 3]
+equal: true
+equal: false
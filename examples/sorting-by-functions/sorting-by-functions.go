@@ -1,16 +1,26 @@
 // 有时候，我们可能想根据自然顺序以外的方式来对集合进行排序。
 // 例如，假设我们要按字符串的长度而不是按字母顺序对它们进行排序。
-// 这儿有一个在 Go 中自定义排序的示例。
+// 这儿有一些在 Go 中自定义排序的示例。
+//
+// 从 Go 1.21 开始，泛型的 `slices` 包提供了 `slices.SortFunc`、
+// `slices.SortStableFunc` 和 `slices.BinarySearch` 等函数，
+// 比较函数直接接收两个元素并返回一个整数（通常借助 `cmp.Compare`），
+// 不需要通过下标访问切片，类型也更安全。
+// 新代码通常优先使用它们，参考 [slices 和 maps 包](slices-maps-packages)的例子。
+// 不过 `sort` 包仍然广泛存在于现有的代码中，这里我们来看看它的用法，
+// 最后再和 `slices.SortFunc` 做个对比。
 
 package main
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 	"sort"
 )
 
 // 为了在 Go 中使用自定义函数进行排序，我们需要一个对应的类型。
-// 我们在这里创建了一个 `byLength` 类型，它只是内建类型 `[]string` 的别名。
+// 我们在这里创建了一个 `byLength` 类型，它的底层类型是内建类型 `[]string`。
 type byLength []string
 
 // 我们为该类型实现了 `sort.Interface` 接口的 `Len`、`Less` 和 `Swap` 方法，
@@ -29,10 +39,99 @@ func (s byLength) Less(i, j int) bool {
 	return len(s[i]) < len(s[j])
 }
 
-// 一切准备就绪后，我们就可以通过将切片 `fruits` 强转为 `byLength` 类型的切片，
-// 然后对该切片使用 `sort.Sort` 来实现自定义排序。
+// 对结构体排序时，常常需要按多个键排序。
+type person struct {
+	name string
+	age  int
+}
+
+// `byAgeName` 先按年龄排序，年龄相同时再按名字排序。
+// `Less` 只在前一个键相等时才比较下一个键。
+type byAgeName []person
+
+func (s byAgeName) Len() int {
+	return len(s)
+}
+func (s byAgeName) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+func (s byAgeName) Less(i, j int) bool {
+	if s[i].age != s[j].age {
+		return s[i].age < s[j].age
+	}
+	return s[i].name < s[j].name
+}
+
 func main() {
+
+	// 对于内建类型，`sort` 包直接提供了 `sort.Ints`、
+	// `sort.Strings` 等函数，参考[排序](sorting)的例子。
+	ints := []int{7, 2, 4}
+	sort.Ints(ints)
+	fmt.Println(ints)
+	strs := []string{"c", "a", "b"}
+	sort.Strings(strs)
+	fmt.Println(strs)
+
+	// 一切准备就绪后，我们就可以通过将切片 `fruits` 转换为 `byLength` 类型，
+	// 然后对该切片使用 `sort.Sort` 来实现自定义排序。
 	fruits := []string{"peach", "banana", "kiwi"}
 	sort.Sort(byLength(fruits))
 	fmt.Println(fruits)
+
+	people := []person{
+		{"Jax", 37}, {"Alex", 72}, {"TJ", 25}, {"Bo", 37},
+	}
+	sort.Sort(byAgeName(people))
+	fmt.Println(people)
+
+	// 为每种排序方式都定义一个类型有些繁琐。
+	// `sort.Slice` 接受一个切片和一个比较函数，
+	// 比较函数通过闭包访问切片，按照下标 `i` 和 `j` 比较两个元素。
+	sort.Slice(people, func(i, j int) bool {
+		return people[i].name < people[j].name
+	})
+	fmt.Println(people)
+
+	// `sort.Slice` 不保证相等元素的相对顺序。
+	// 如果需要保持原有的顺序，可以使用 `sort.SliceStable`。
+	// 这里按年龄排序，年龄相同的 `Jax` 和 `Alex`
+	// 保持了它们在原切片中的顺序。
+	people = []person{
+		{"Jax", 37}, {"Alex", 37}, {"TJ", 25}, {"Bo", 72},
+	}
+	sort.SliceStable(people, func(i, j int) bool {
+		return people[i].age < people[j].age
+	})
+	fmt.Println(people)
+
+	// `sort.Search` 在有序的切片中进行二分查找：
+	// 它返回使 `f(i)` 为 `true` 的最小下标 `i`，
+	// 前提是 `f` 对前面的元素都为 `false`、对后面的元素都为 `true`。
+	// 如果没有这样的下标，它返回切片的长度。
+	sorted := []int{1, 3, 5, 7, 9}
+	for _, x := range []int{7, 4, 10} {
+		i := sort.Search(len(sorted), func(i int) bool {
+			return sorted[i] >= x
+		})
+		found := i < len(sorted) && sorted[i] == x
+		fmt.Println(x, i, found)
+	}
+
+	// 作为对比，下面用 `sort.Slice` 和 `slices.SortFunc`
+	// 分别对同一组数据按长度排序。`sort.Slice` 的比较函数
+	// 通过下标访问闭包捕获的切片，返回 `bool`；
+	// `slices.SortFunc` 的比较函数直接接收两个元素，
+	// 返回一个整数，不需要再引用切片本身。
+	legacy := []string{"peach", "banana", "kiwi"}
+	sort.Slice(legacy, func(i, j int) bool {
+		return len(legacy[i]) < len(legacy[j])
+	})
+	fmt.Println("sort.Slice:", legacy)
+
+	modern := []string{"peach", "banana", "kiwi"}
+	slices.SortFunc(modern, func(a, b string) int {
+		return cmp.Compare(len(a), len(b))
+	})
+	fmt.Println("slices.SortFunc:", modern)
 }
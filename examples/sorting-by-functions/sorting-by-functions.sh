@@ -1,7 +1,17 @@
 # 运行这个程序，和预期的一样，
-# 显示了一个按照字符串长度排序的列表。
+# 显示了按照字符串长度排序的列表，以及按照不同的键排序的结构体。
 $ go run sorting-by-functions.go 
+[2 4 7]
+[a b c]
 [kiwi peach banana]
+[{TJ 25} {Bo 37} {Jax 37} {Alex 72}]
+[{Alex 72} {Bo 37} {Jax 37} {TJ 25}]
+[{TJ 25} {Jax 37} {Alex 37} {Bo 72}]
+7 3 true
+4 2 false
+10 5 false
+sort.Slice: [kiwi peach banana]
+slices.SortFunc: [kiwi peach banana]
 
 # 类似的，参照这个例子，创建一个自定义类型，
 # 为它实现 `Interface` 接口的三个方法，
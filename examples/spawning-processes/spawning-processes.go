@@ -1,56 +1,148 @@
+// noplay
+
 // 有时，我们的 Go 程序需要生成其他的、非 Go 的进程。
 
 package main
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
 	"io"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
 )
 
+// 同一个命令，例如 `date`、`grep` 或 `bash`，
+// 并不是在每个操作系统上都存在，Windows 上的可执行文件
+// 还需要 `.exe` 后缀。为了让这个例子在任何地方都能运行，
+// 程序会重新执行自己作为子进程：通过环境变量 `childEnv`
+// 告诉子进程应该扮演哪个角色。
+const childEnv = "SPAWN_CHILD"
+
+func child(role string) {
+	switch role {
+	case "hello":
+		fmt.Println("hello from the child")
+	case "lines":
+		for i := 1; i <= 3; i++ {
+			fmt.Println("line", i)
+		}
+	case "upper":
+		in, _ := io.ReadAll(os.Stdin)
+		fmt.Print(strings.ToUpper(string(in)))
+	case "env":
+		fmt.Println("GREETING:", os.Getenv("GREETING"))
+		entries, _ := os.ReadDir(".")
+		for _, e := range entries {
+			fmt.Println("file:", e.Name())
+		}
+	case "fail":
+		fmt.Fprintln(os.Stderr, "something went wrong")
+		os.Exit(3)
+	}
+}
+
 func main() {
+	if role := os.Getenv(childEnv); role != "" {
+		child(role)
+		return
+	}
+
+	// `os.Executable` 返回当前程序的绝对路径。
+	// `os.Args[0]` 也可以，但它可能是一个相对路径，
+	// 在下面修改了子进程的工作目录之后就找不到了。
+	self, err := os.Executable()
+	if err != nil {
+		panic(err)
+	}
 
-	// 我们将从一个简单的命令开始，没有参数或者输入，仅打印一些信息到标准输出流。
 	// `exec.Command` 可以帮助我们创建一个对象，来表示这个外部进程。
-	dateCmd := exec.Command("date")
+	// 注意，我们需要提供一个明确描述命令和参数的列表，
+	// 而不能只传递一个命令行字符串，参数也不会经过 shell 的展开。
+	// `cmd.Environ` 返回子进程默认的环境变量，
+	// 我们在其后追加一项，设置子进程的角色。
+	command := func(role string) *exec.Cmd {
+		cmd := exec.Command(self)
+		cmd.Env = append(cmd.Environ(), childEnv+"="+role)
+		return cmd
+	}
 
-	// `.Output` 是另一个帮助函数，常用于处理运行命令、等待命令完成并收集其输出。
-	// 如果没有错误，`dateOut` 将保存带有日期信息的字节。
-	dateOut, err := dateCmd.Output()
+	// `CombinedOutput` 运行命令、等待它结束，
+	// 并返回它的标准输出和标准错误合并在一起的内容。
+	out, err := command("hello").CombinedOutput()
 	if err != nil {
 		panic(err)
 	}
-	fmt.Println("> date")
-	fmt.Println(string(dateOut))
-
-	// 下面我们将看看一个稍复杂的例子，
-	// 我们将从外部进程的 `stdin` 输入数据并从 `stdout` 收集结果。
-	grepCmd := exec.Command("grep", "hello")
-
-	// 这里我们明确的获取输入/输出管道，运行这个进程，
-	// 写入一些输入数据、读取输出结果，最后等待程序运行结束。
-	grepIn, _ := grepCmd.StdinPipe()
-	grepOut, _ := grepCmd.StdoutPipe()
-	grepCmd.Start()
-	grepIn.Write([]byte("hello grep\ngoodbye grep"))
-	grepIn.Close()
-	grepBytes, _ := io.ReadAll(grepOut)
-	grepCmd.Wait()
-
-	// 上面的例子中，我们忽略了错误检测，
-	// 当然，你也可以使用常见的 `if err != nil` 方式来进行错误检查。
-	// 我们只收集了 `StdoutPipe` 的结果，
-	// 但是你可以使用相同的方法收集 `StderrPipe` 的结果。
-	fmt.Println("> grep hello")
-	fmt.Println(string(grepBytes))
-
-	// 注意，在生成命令时，我们需要提供一个明确描述命令和参数的数组，而不能只传递一个命令行字符串。
-	// 如果你想使用一个字符串生成一个完整的命令，那么你可以使用 `bash` 命令的 `-c` 选项：
-	lsCmd := exec.Command("bash", "-c", "ls -a -l -h")
-	lsOut, err := lsCmd.Output()
+	fmt.Print("> hello\n", string(out))
+
+	// 如果想在子进程运行期间就处理它的输出，可以使用
+	// `StdoutPipe`。`Start` 启动进程但不等待它结束，
+	// 读完输出之后，再调用 `Wait` 等待进程退出并释放资源。
+	cmd := command("lines")
+	stdout, err := cmd.StdoutPipe()
 	if err != nil {
 		panic(err)
 	}
-	fmt.Println("> ls -a -l -h")
-	fmt.Println(string(lsOut))
+	if err := cmd.Start(); err != nil {
+		panic(err)
+	}
+	fmt.Println("> lines")
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		fmt.Println("read:", scanner.Text())
+	}
+	if err := cmd.Wait(); err != nil {
+		panic(err)
+	}
+
+	// 通过 `StdinPipe` 向子进程的标准输入写入数据。
+	// 写完之后要关闭它，子进程才能读到输入的结尾。
+	cmd = command("upper")
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		panic(err)
+	}
+	go func() {
+		defer stdin.Close()
+		io.WriteString(stdin, "hello\ngoodbye\n")
+	}()
+	out, err = cmd.Output()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print("> upper\n", string(out))
+
+	// `cmd.Env` 设置子进程的环境变量，
+	// `cmd.Dir` 设置子进程的工作目录。
+	dir, err := os.MkdirTemp("", "spawn")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	data := filepath.Join(dir, "data.txt")
+	if err := os.WriteFile(data, nil, 0644); err != nil {
+		panic(err)
+	}
+	cmd = command("env")
+	cmd.Env = append(cmd.Env, "GREETING=hola")
+	cmd.Dir = dir
+	out, err = cmd.Output()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print("> env\n", string(out))
+
+	// 如果子进程以非零的状态码退出，返回的错误是
+	// `*exec.ExitError`，从中可以获得退出码。
+	// 而当命令根本无法启动时（例如找不到可执行文件），
+	// 返回的则是其他类型的错误。
+	out, err = command("fail").CombinedOutput()
+	fmt.Print("> fail\n", string(out))
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		fmt.Println("exit code:", exitErr.ExitCode())
+	}
 }
@@ -1,3 +1,5 @@
+// norun
+
 // 在前面的例子中，我们用 [互斥锁](mutexes) 进行了明确的锁定，
 // 来让共享的 state 跨多个 Go 协程同步访问。
 // 另一个选择是，使用内建协程和通道的同步特性来达到同样的效果。
@@ -0,0 +1,104 @@
+// Go 中的字符串是不可变的，每次用 `+` 或 `+=` 拼接，
+// 都会分配一个新的字符串，并把原来的内容整个复制一遍。
+// 在循环中这样拼接 n 段内容，总共复制的字节数与 n² 成正比。
+// `strings.Builder` 在一块可以增长的缓冲区中追加数据，
+// 只在最后得到结果时创建一次字符串。
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// `concat` 用 `+=` 拼接 `parts`，
+// 并统计在这个过程中一共复制了多少字节。
+func concat(parts []string) (string, int) {
+	s, copied := "", 0
+	for _, p := range parts {
+		s += p
+		copied += len(s)
+	}
+	return s, copied
+}
+
+func main() {
+
+	// 拼接 1000 个单字节的字符串，结果只有 1000 字节，
+	// 但 `+=` 一共复制了 1+2+...+1000 个字节。
+	parts := strings.Split(strings.Repeat("x", 1000), "")
+	s, copied := concat(parts)
+	fmt.Println("+=:", len(s), "bytes,", copied, "copied")
+
+	// 如果事先知道结果的大概长度，可以先调用 `Grow` 预留空间，
+	// 之后的写入就不需要再重新分配缓冲区了。
+	var b strings.Builder
+	b.Grow(len(parts))
+	capacity := b.Cap()
+	for _, p := range parts {
+		b.WriteString(p)
+	}
+	fmt.Println("Builder:", b.Len(), "bytes, grew:",
+		b.Cap() != capacity)
+
+	// 下面用几种不同的方法拼出同一个字符串。
+	words := []string{"go", "by", "example"}
+
+	// `+` 适合把少量、固定数目的字符串拼在一起，
+	// 编译器会把单个表达式中的多次 `+` 合并为一次分配。
+	plus := words[0] + ", " + words[1] + ", " +
+		words[2] + "!"
+
+	// `strings.Builder` 的零值就可以直接使用。
+	// `WriteString`、`WriteByte` 和 `WriteRune` 分别追加
+	// 一个字符串、一个字节和一个（可能是多字节的）rune。
+	// 它还实现了 `io.Writer`，所以也可以作为 `fmt.Fprintf` 的目标。
+	// `String` 返回结果，而不会再复制一遍缓冲区。
+	var sb strings.Builder
+	for i, w := range words {
+		if i > 0 {
+			sb.WriteByte(',')
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(w)
+	}
+	sb.WriteRune('!')
+	built := sb.String()
+
+	// 如果所有的段都已经在一个切片中，`strings.Join` 最简单，
+	// 它会先计算出总长度，只分配一次。
+	joined := strings.Join(words, ", ") + "!"
+
+	// `bytes.Buffer` 也能完成同样的事情，而且还能从中读取数据。
+	// 但它的 `String` 方法会复制一次内容，
+	// 只需要构建字符串的时候，`strings.Builder` 更合适。
+	var buf bytes.Buffer
+	buf.WriteString(strings.Join(words, ", "))
+	buf.WriteByte('!')
+	buffered := buf.String()
+
+	// `fmt.Sprintf` 在需要格式化的时候最方便，
+	// 但它需要解析格式字符串，比上面的方法都慢。
+	sprinted := fmt.Sprintf("%s, %s, %s!",
+		words[0], words[1], words[2])
+
+	for _, r := range []string{
+		plus, built, joined, buffered, sprinted} {
+		fmt.Printf("%q %v\n", r, r == plus)
+	}
+
+	fmt.Println("copy panics:", copyPanics(&sb))
+}
+
+// `Builder` 在第一次写入之后就不能再被复制了：
+// 副本和原来的值共享同一块缓冲区，向副本写入会导致 panic。
+// 需要传递时请使用指针 `*strings.Builder`。
+func copyPanics(b *strings.Builder) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	c := *b
+	c.WriteString("oops")
+	return false
+}
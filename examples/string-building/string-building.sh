@@ -0,0 +1,11 @@
+# `+=` 复制的字节数远远超过了结果的长度，
+# 而预先 `Grow` 的 `Builder` 没有再重新分配缓冲区。
+$ go run string-building.go
++=: 1000 bytes, 500500 copied
+Builder: 1000 bytes, grew: false
+"go, by, example!" true
+"go, by, example!" true
+"go, by, example!" true
+"go, by, example!" true
+"go, by, example!" true
+copy panics: true
@@ -1,3 +1,5 @@
+// norun
+
 // Go 在传统的 `printf` 中对字符串格式化提供了优异的支持。
 // 这儿有一些基本的字符串格式化的任务的例子。
 
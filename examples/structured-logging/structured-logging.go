@@ -0,0 +1,66 @@
+// Go 1.21 在标准库中加入了 `log/slog` 包，用于输出 *结构化日志*：
+// 每条日志除了消息之外，还带有一组键值对形式的属性，
+// 便于日志系统对其进行检索和分析。
+
+package main
+
+import (
+	"log/slog"
+	"os"
+)
+
+// 日志中默认包含时间戳，但这会让每次运行的输出都不一样。
+// `ReplaceAttr` 可以在输出前改写或删除属性，
+// 这里我们删除顶层的 `time` 属性，使输出保持稳定。
+// 返回一个空的 `slog.Attr` 表示丢弃该属性。
+func dropTime(groups []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.TimeKey && len(groups) == 0 {
+		return slog.Attr{}
+	}
+	return a
+}
+
+func main() {
+
+	// `slog.NewJSONHandler` 创建一个以 JSON 格式输出日志的 handler，
+	// 再使用 `slog.New` 基于它创建 logger。
+	opts := &slog.HandlerOptions{ReplaceAttr: dropTime}
+	handler := slog.NewJSONHandler(os.Stdout, opts)
+	logger := slog.New(handler)
+
+	// 日志方法的第一个参数是消息，后面是交替出现的键和值。
+	logger.Info("server started", "port", 8080)
+	logger.Warn("disk almost full", "free", "2GB")
+	logger.Error("request failed", "status", 500)
+
+	// `With` 返回一个子 logger，
+	// 它输出的每条日志都会带上给定的公共属性。
+	reqLog := logger.With("request_id", "abc123")
+	reqLog.Info("handling request", "path", "/hello")
+
+	// `slog.Group` 把多个属性组合在一个键下，
+	// 在 JSON 中会输出为嵌套的对象。
+	logger.Info("user login",
+		slog.Group("user", "id", 42, "name", "gopher"))
+
+	// 也可以使用 `TextHandler` 输出 `key=value` 格式的日志。
+	// `HandlerOptions.Level` 设置了最低的日志级别，
+	// 低于该级别的日志将被忽略；默认的级别是 Info。
+	// 使用 `slog.LevelVar` 可以在运行时修改这个级别。
+	// 这里先把级别提高到 Warn，Info 日志就被忽略了。
+	level := new(slog.LevelVar)
+	level.Set(slog.LevelWarn)
+	textOpts := &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: dropTime,
+	}
+	text := slog.New(
+		slog.NewTextHandler(os.Stdout, textOpts))
+	text.Info("cache hit", "key", "user:42")
+	text.Warn("cache full", "size", 100)
+
+	// 把级别降低到 Debug 之后，连 Debug 日志也会输出，
+	// 排查问题时可以临时这样做，而不需要重新创建 logger。
+	level.Set(slog.LevelDebug)
+	text.Debug("cache miss", "key", "user:42")
+}
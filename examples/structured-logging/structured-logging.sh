@@ -0,0 +1,12 @@
+# 为了便于阅读，这里对较长的输出进行了换行，
+# 实际运行时每条日志都输出在同一行中。
+$ go run structured-logging.go
+{"level":"INFO","msg":"server started","port":8080}
+{"level":"WARN","msg":"disk almost full","free":"2GB"}
+{"level":"ERROR","msg":"request failed","status":500}
+{"level":"INFO","msg":"handling request",
+  "request_id":"abc123","path":"/hello"}
+{"level":"INFO","msg":"user login",
+  "user":{"id":42,"name":"gopher"}}
+level=WARN msg="cache full" size=100
+level=DEBUG msg="cache miss" key=user:42
@@ -0,0 +1,114 @@
+// Go 内建的 map 不是并发安全的：多个协程同时读写同一个 map
+// 会导致程序崩溃。`sync.Map` 是标准库提供的并发安全的 map，
+// 但它并不是所有并发场景下的首选，我们来看看它的用法和适用场景。
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// 大多数情况下，用一个互斥锁保护普通的 map 就足够了。
+// 它有明确的类型，可以对多个操作加同一把锁，也更容易理解。
+type counters struct {
+	mu sync.Mutex
+	m  map[string]int
+}
+
+func (c *counters) inc(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.m[key]++
+}
+
+func main() {
+
+	// `sync.Map` 的零值就可以直接使用。
+	// 它的键和值都是 `any` 类型，取出时需要类型断言。
+	// 根据文档，`sync.Map` 针对两种场景做了优化：
+	// 一是每个键只写入一次、之后被大量地读取，例如只增不减的缓存；
+	// 二是多个协程读写互不相交的键的集合。
+	// 在这些场景下，它比互斥锁保护的 map 竞争更少。
+	// 其他情况下，尤其是需要频繁更新同一个键时，
+	// 互斥锁加普通 map 通常更快，也更不容易出错。
+	var m sync.Map
+	var wg sync.WaitGroup
+
+	// 启动几个协程，每个协程写入自己的键，这正是第二种场景。
+	for w := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 5 {
+				key := fmt.Sprintf("w%d-%d", w, i)
+				m.Store(key, w*10+i)
+			}
+		}()
+	}
+
+	// 这些协程同时用 `LoadOrStore` 写入同一个键：
+	// 如果键已经存在，它返回已有的值，`loaded` 为 `true`；
+	// 否则存入给定的值。所以只有一个协程的值会被存入。
+	var stored sync.Map
+	for w := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, loaded := m.LoadOrStore("config", w)
+			stored.Store(w, !loaded)
+		}()
+	}
+	wg.Wait()
+
+	// `Load` 读取一个键，第二个返回值表示键是否存在。
+	v, ok := m.Load("w2-3")
+	fmt.Println("w2-3:", v, ok)
+	_, ok = m.Load("missing")
+	fmt.Println("missing:", ok)
+
+	// 统计有几个协程成功存入了 `config`。
+	winners := 0
+	stored.Range(func(_, won any) bool {
+		if won.(bool) {
+			winners++
+		}
+		return true
+	})
+	fmt.Println("config stored by", winners, "goroutine")
+
+	// `LoadAndDelete` 删除一个键，并返回删除前的值。
+	// `Delete` 只删除，不返回任何值。
+	v, loaded := m.LoadAndDelete("w0-0")
+	fmt.Println("deleted w0-0:", v, loaded)
+	m.Delete("config")
+
+	// `Range` 遍历所有的键值对，返回 `false` 可以提前停止遍历。
+	// 与 map 一样，遍历的顺序是不确定的，所以我们先收集键，
+	// 排序后再打印。
+	var keys []string
+	m.Range(func(k, _ any) bool {
+		keys = append(keys, k.(string))
+		return true
+	})
+	slices.Sort(keys)
+	fmt.Println("keys:", len(keys))
+	fmt.Println("first:", keys[:3])
+
+	// 作为对比，这里用互斥锁保护的 map 统计单词出现的次数：
+	// 多个协程反复更新相同的键，这正是 `sync.Map` 不擅长的场景。
+	c := counters{m: map[string]int{}}
+	words := []string{"go", "map", "go", "sync", "go"}
+	for range 10 {
+		for _, word := range words {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.inc(word)
+			}()
+		}
+	}
+	wg.Wait()
+	fmt.Println("counts:", c.m)
+}
@@ -0,0 +1,10 @@
+# 每个协程写入了 5 个键，删除 `w0-0` 和 `config` 后
+# 还剩 19 个。`LoadOrStore` 保证了只有一个协程写入了 `config`。
+$ go run sync-map.go
+w2-3: 23 true
+missing: false
+config stored by 1 goroutine
+deleted w0-0: 0 true
+keys: 19
+first: [w0-1 w0-2 w0-3]
+counts: map[go:30 map:10 sync:10]
@@ -0,0 +1,73 @@
+// 有时我们希望某个初始化操作只执行一次，并且推迟到第一次用到时才执行，
+// 即使有多个协程同时需要它。`sync.Once` 正是为此而生的。
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	once      sync.Once
+	initCount int
+)
+
+// `initConfig` 是只应执行一次的初始化函数。
+func initConfig() {
+	initCount++
+	fmt.Println("initializing config")
+}
+
+func main() {
+
+	// 启动多个协程，每个协程都调用 `once.Do(initConfig)`。
+	// 无论有多少个协程调用它，`initConfig` 都只会执行一次；
+	// 其他调用者会等待这次执行完成后再返回，
+	// 因此 `Do` 返回之后，初始化的结果总是可见的。
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			once.Do(initConfig)
+		}()
+	}
+	wg.Wait()
+	fmt.Println("init ran", initCount, "time(s)")
+
+	// Go 1.21 新增了几个基于 `sync.Once` 的辅助函数。
+	// `sync.OnceFunc` 将一个函数包装为只执行一次的函数，
+	// 省去了单独声明一个 `sync.Once` 变量。
+	greet := sync.OnceFunc(func() {
+		fmt.Println("hello, once")
+	})
+	greet()
+	greet()
+
+	// `sync.OnceValue` 包装一个有返回值的函数：
+	// 第一次调用时执行计算，之后的调用直接返回缓存的结果。
+	// 这很适合用来延迟执行开销较大的计算。
+	total := sync.OnceValue(func() int {
+		fmt.Println("computing total")
+		sum := 0
+		for i := 1; i <= 100; i++ {
+			sum += i
+		}
+		return sum
+	})
+	fmt.Println("total:", total())
+	fmt.Println("total:", total())
+
+	// `sync.OnceValues` 与之类似，但返回两个值，
+	// 通常是一个结果和一个 `error`。错误同样会被缓存下来。
+	load := sync.OnceValues(func() (string, error) {
+		fmt.Println("loading config")
+		return "", errors.New("config not found")
+	})
+	for i := 0; i < 2; i++ {
+		cfg, err := load()
+		fmt.Printf("config: %q, err: %v\n", cfg, err)
+	}
+}
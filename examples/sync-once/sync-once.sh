@@ -0,0 +1,11 @@
+# 尽管有 10 个协程调用了 `once.Do`，初始化函数只执行了一次。
+$ go run sync-once.go
+initializing config
+init ran 1 time(s)
+hello, once
+computing total
+total: 5050
+total: 5050
+loading config
+config: "", err: config not found
+config: "", err: config not found
@@ -0,0 +1,120 @@
+// `net` 包提供了 TCP、UDP 等网络连接的底层接口。
+// 这里我们实现一个简单的 TCP 回显（echo）服务器，
+// 并在同一个程序中用客户端连接它。
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+// 连接在空闲了这么长时间之后会被服务器关闭。
+const idleTimeout = 5 * time.Second
+
+// `serve` 是服务器的 accept 循环：`Accept` 会阻塞，
+// 直到有新的连接到来。每个连接交给一个单独的协程处理，
+// 这样一个缓慢的客户端不会阻塞其他客户端，
+// 循环可以马上回去接受下一个连接。
+// 监听器被关闭后，`Accept` 返回 `net.ErrClosed`，循环随之结束。
+func serve(ln net.Listener, wg *sync.WaitGroup) {
+	for {
+		conn, err := ln.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return
+		}
+		if err != nil {
+			fmt.Println("server: accept:", err)
+			continue
+		}
+		wg.Go(func() { handle(conn) })
+	}
+}
+
+// `handle` 逐行读取客户端发来的数据，并原样写回。
+// 每读到一行之前都会刷新连接的截止时间，
+// 如果客户端空闲太久，读取就会因超时而失败，
+// 从而避免一个不活动的连接永远占用一个协程。
+func handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		conn.SetDeadline(time.Now().Add(idleTimeout))
+		line, err := r.ReadString('\n')
+		if err == io.EOF {
+			// 客户端关闭了它那一端的写入。
+			fmt.Println("server: client done")
+			return
+		}
+		if err != nil {
+			fmt.Println("server:", err)
+			return
+		}
+		_, err = io.WriteString(conn, line)
+		if err != nil {
+			fmt.Println("server:", err)
+			return
+		}
+	}
+}
+
+func main() {
+
+	// 端口 `0` 让操作系统分配一个空闲的端口，
+	// 然后通过 `ln.Addr()` 得到实际监听的地址。
+	// 这样例子不依赖某个固定的端口是否可用。
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	var wg sync.WaitGroup
+	wg.Go(func() { serve(ln, &wg) })
+
+	// 客户端使用 `net.Dial` 连接到服务器。
+	// `net.Conn` 同时实现了 `io.Reader` 和 `io.Writer`，
+	// 因此可以和 `bufio`、`fmt` 等包配合使用。
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		panic(err)
+	}
+	r := bufio.NewReader(conn)
+	for _, msg := range []string{"hello", "gopher"} {
+		fmt.Fprintln(conn, msg)
+		echo, err := r.ReadString('\n')
+		if err != nil {
+			panic(err)
+		}
+		fmt.Print("client: echo ", echo)
+	}
+
+	// `SetReadDeadline` 为读取设置一个截止时间。
+	// 服务器不会主动发送数据，所以这次读取会因超时而失败，
+	// 返回的错误满足 `os.ErrDeadlineExceeded`。
+	// 传入零值的 `time.Time` 可以清除截止时间。
+	deadline := time.Now().Add(50 * time.Millisecond)
+	conn.SetReadDeadline(deadline)
+	_, err = r.ReadString('\n')
+	fmt.Println("client: timed out:",
+		errors.Is(err, os.ErrDeadlineExceeded))
+	conn.SetReadDeadline(time.Time{})
+
+	// 优雅地关闭连接：`CloseWrite` 只关闭写入的一端，
+	// 服务器会读到 `io.EOF`，然后关闭它那一端，
+	// 客户端接着也读到 `io.EOF`，确认所有数据都已交换完毕。
+	conn.(*net.TCPConn).CloseWrite()
+	_, err = r.ReadString('\n')
+	fmt.Println("client: server closed:", err == io.EOF)
+	conn.Close()
+
+	// 关闭监听器会让 accept 循环退出，
+	// 再等待所有处理连接的协程结束。
+	ln.Close()
+	wg.Wait()
+	fmt.Println("server: stopped")
+}
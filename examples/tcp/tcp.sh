@@ -0,0 +1,8 @@
+# 运行程序，服务器和客户端在同一个进程中通过回环地址通信。
+$ go run tcp.go
+client: echo hello
+client: echo gopher
+client: timed out: true
+server: client done
+client: server closed: true
+server: stopped
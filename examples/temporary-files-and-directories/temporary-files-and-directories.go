@@ -9,19 +9,28 @@ import (
 	"path/filepath"
 )
 
-func check(e error) {
-	if e != nil {
-		panic(e)
+// 清理临时文件时，必须先关闭文件，再删除它：
+// 在 Windows 下，无法删除一个仍处于打开状态的文件。
+// 这里将两步按顺序写在同一个函数中，而不是依赖多个 `defer`
+// “后进先出”的执行顺序，并把遇到的错误返回给调用者。
+func removeFile(f *os.File) error {
+	if err := f.Close(); err != nil {
+		return err
 	}
+	return os.Remove(f.Name())
 }
 
-func main() {
+// `run` 通过命名返回值 `err` 返回错误，
+// 这样被 defer 的清理函数也能将错误报告出去。
+func run() (err error) {
 
 	// 创建临时文件最简单的方法是调用 `os.CreateTemp` 函数。
 	// 它会创建并打开文件，我们可以对文件进行读写。
 	// 函数的第一个参数传 `""`，`os.CreateTemp` 会在操作系统的默认位置下创建该文件。
 	f, err := os.CreateTemp("", "sample")
-	check(err)
+	if err != nil {
+		return err
+	}
 
 	// 打印临时文件的名称。
 	// 文件名以 `os.CreateTemp` 函数的第二个参数作为前缀，
@@ -29,32 +38,44 @@ func main() {
 	// 在类 Unix 操作系统下，临时目录一般是 `/tmp`。
 	fmt.Println("Temp file name:", f.Name())
 
-	// defer 删除该文件。
+	// defer 关闭并删除该文件。
 	// 尽管操作系统会自动在某个时间清理临时文件，但手动清理是一个好习惯。
-	defer os.Remove(f.Name())
-	
-	// 当然如果这样去移除所创建的临时文件，那么其实会出现error（`The process cannot access the file because it is being used by another process.`），
-	// 首先解释一下这个error为什么会出现或者说为什么只有一个`defer os.Remove()`是无法删掉临时文件的。
-	// 其实很简单，就是所创建的file可以还在“写”的过程，那么当我们去删掉该文件的时候，就会出现error，这是很显而易见的error；
-	// 那么解决方法也很简单，结束“写”的过程；那么就可以正常的删除了。
-	defer f.Close()
+	// 如果清理失败，并且此前没有出现其他错误，就返回清理时的错误。
+	defer func() {
+		if rerr := removeFile(f); err == nil {
+			err = rerr
+		}
+	}()
 
 	// 我们可以向文件写入一些数据。
-	_, err = f.Write([]byte{1, 2, 3, 4})
-	check(err)
+	if _, err := f.Write([]byte{1, 2, 3, 4}); err != nil {
+		return err
+	}
 
 	// 如果需要写入多个临时文件，最好是为其创建一个临时 *目录* 。
 	// `os.MkdirTemp` 的参数与 `CreateTemp` 相同，
 	// 但是它返回的是一个 *目录名* ，而不是一个打开的文件。
 	dname, err := os.MkdirTemp("", "sampledir")
-	check(err)
+	if err != nil {
+		return err
+	}
 	fmt.Println("Temp dir name:", dname)
 
-	// 对于目录不存在上述问题。
-	defer os.RemoveAll(dname)
+	// 目录没有需要关闭的文件句柄，直接 defer 删除即可。
+	defer func() {
+		if rerr := os.RemoveAll(dname); err == nil {
+			err = rerr
+		}
+	}()
 
 	// 现在，我们可以通过拼接临时目录和临时文件合成完整的临时文件路径，并写入数据。
 	fname := filepath.Join(dname, "file1")
-	err = os.WriteFile(fname, []byte{1, 2}, 0666)
-	check(err)
+	return os.WriteFile(fname, []byte{1, 2}, 0666)
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 }
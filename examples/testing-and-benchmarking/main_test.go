@@ -63,7 +63,8 @@ func TestIntMinTableDriven(t *testing.T) {
 // 直到它收集到精确的测量值。
 func BenchmarkIntMin(b *testing.B) {
 	// 通常，基准测试运行一个函数，我们在一个 `b.N` 次的循环内进行基准测试。
-	for i := 0; i < b.N; i++ {
+	// 从 Go 1.22 开始，可以直接使用 `for range b.N` 编写这个循环。
+	for range b.N {
 		IntMin(1, 2)
 	}
 }
@@ -1,6 +1,6 @@
 # 以啰嗦模式运行当前项目下的所有测试。
 $ go test -v
-== RUN   TestIntMinBasic
+=== RUN   TestIntMinBasic
 --- PASS: TestIntMinBasic (0.00s)
 === RUN   TestIntMinTableDriven
 === RUN   TestIntMinTableDriven/0,1
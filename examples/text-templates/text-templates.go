@@ -4,10 +4,19 @@
 package main
 
 import (
+	htmltemplate "html/template"
 	"os"
+	"strings"
 	"text/template"
 )
 
+// 下面的例子都会渲染这条固定的评论数据。
+type comment struct {
+	Author string
+	Body   string
+	Tags   []string
+}
+
 func main() {
 
 	// 我们可以创建一个新模板，并从字符串解析其正文。
@@ -69,4 +78,35 @@ func main() {
 			"C++",
 			"C#",
 		})
+
+	// 通过 `Funcs` 可以向模板注册自定义函数，
+	// 注册必须在 `Parse` 之前完成，这样解析器才能识别它们。
+	// 在模板中，可以像调用内置函数一样调用它们，
+	// 也可以使用 `|` 将前一个值作为最后一个参数传入。
+	funcs := template.FuncMap{"upper": strings.ToUpper}
+	const page = "{{.Author | upper}}: {{.Body}}" +
+		"{{range .Tags}} #{{.}}{{end}}\n"
+	t5 := template.Must(template.New("t5").
+		Funcs(funcs).Parse(page))
+
+	// 这条评论中包含了一段恶意的 `<script>`。
+	c := comment{
+		Author: "mallory",
+		Body:   "<script>alert(1)</script>",
+		Tags:   []string{"go", "web"},
+	}
+	t5.Execute(os.Stdout, c)
+
+	// `text/template` 原样输出了数据，如果把它的结果
+	// 当作 HTML 发送给浏览器，这段脚本就会被执行。
+	// 用 `html/template` 渲染同样的模板和数据，
+	// 它会根据值所处的上下文（HTML 正文、属性、
+	// JavaScript、URL 等）自动进行转义，从而防止 XSS 攻击。
+	t6 := htmltemplate.Must(htmltemplate.New("t6").
+		Funcs(htmltemplate.FuncMap(funcs)).Parse(page))
+	t6.Execute(os.Stdout, c)
+
+	// 因此，生成 HTML 时总是应该使用 `html/template`；
+	// 而生成纯文本、配置文件或代码等其他内容时，
+	// 则使用 `text/template`，它不会改动任何输出。
 }
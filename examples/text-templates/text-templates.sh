@@ -7,3 +7,5 @@ Name: Mickey Mouse
 yes 
 no 
 Range: Go Rust C++ C# 
+MALLORY: <script>alert(1)</script> #go #web
+MALLORY: &lt;script&gt;alert(1)&lt;/script&gt; #go #web
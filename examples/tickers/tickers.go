@@ -12,26 +12,31 @@ import (
 func main() {
 
 	// 打点器和定时器的机制有点相似：使用一个通道来发送数据。
-	// 这里我们使用通道内建的 `select`，等待每 500ms 到达一次的值。
-	ticker := time.NewTicker(500 * time.Millisecond)
-	done := make(chan bool)
-
-	go func() {
-		for {
-			select {
-			case <-done:
-				return
-			case t := <-ticker.C:
-				fmt.Println("Tick at", t)
-			}
-		}
-	}()
+	// 这里我们每 100ms 从通道中接收一次值，
+	// 打点 3 次之后退出循环。为了让输出保持固定，
+	// 我们打印打点的序号，而不是接收到的时间。
+	ticker := time.NewTicker(100 * time.Millisecond)
+	for i := 1; i <= 3; i++ {
+		<-ticker.C
+		fmt.Println("Tick", i)
+	}
 
 	// 打点器可以和定时器一样被停止。
 	// 打点器一旦停止，将不能再从它的通道中接收到值。
-	// 我们将在运行 1600ms 后停止这个打点器。
-	time.Sleep(1600 * time.Millisecond)
+	// 不再需要打点器时，应该调用 `Stop` 释放它占用的资源，
+	// 通常在创建之后紧跟着写 `defer ticker.Stop()`。
 	ticker.Stop()
-	done <- true
 	fmt.Println("Ticker stopped")
+
+	// `time.Tick` 只返回打点器的通道，没有办法停止它。
+	// 在 Go 1.23 之前，它底层的打点器永远不会被回收，
+	// 造成泄漏；现在不再被引用时它可以被回收了，
+	// 但只要通道还在被使用，它就会一直打点下去。
+	// 所以它只适合在整个程序的生命周期中都需要打点的场景，
+	// 其他情况下请使用 `NewTicker` 并调用 `Stop`。
+	tick := time.Tick(100 * time.Millisecond)
+	for i := 1; i <= 2; i++ {
+		<-tick
+		fmt.Println("time.Tick", i)
+	}
 }
@@ -1,6 +1,9 @@
-# 当我们运行这个程序时，打点器会在我们停止它前打点 3 次。
+# 当我们运行这个程序时，第一个打点器会在我们停止它前打点 3 次，
+# `time.Tick` 的通道则被接收了 2 次。
 $ go run tickers.go
-Tick at 2012-09-23 11:29:56.487625 -0700 PDT
-Tick at 2012-09-23 11:29:56.988063 -0700 PDT
-Tick at 2012-09-23 11:29:57.488076 -0700 PDT
+Tick 1
+Tick 2
+Tick 3
 Ticker stopped
+time.Tick 1
+time.Tick 2
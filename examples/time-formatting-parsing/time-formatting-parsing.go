@@ -5,29 +5,46 @@ package main
 import (
 	"fmt"
 	"time"
+
+	// `LoadLocation` 需要读取时区数据库，有些系统（例如
+	// Windows 或精简的容器镜像）上并没有安装它。
+	// 导入 `time/tzdata` 会把时区数据打包进程序，
+	// 大约让程序增大 450KB。
+	_ "time/tzdata"
 )
 
 func main() {
 	p := fmt.Println
 
+	// 为了让输出固定，这里使用一个固定的时间，
+	// 而不是 `time.Now()`。
+	t := time.Date(2014, 4, 15, 18, 0, 15, 161182000,
+		time.UTC)
+
 	// 这是一个遵循 RFC3339，
 	// 并使用对应的 `布局`（layout）常量进行格式化的基本例子。
-	t := time.Now()
 	p(t.Format(time.RFC3339))
 
 	// 时间解析使用与 `Format` 相同的布局值。
 	t1, e := time.Parse(
 		time.RFC3339,
 		"2012-11-01T22:08:41+00:00")
-	p(t1)
+	p(t1.Format(time.RFC1123Z))
 
-	// `Format` 和 `Parse` 使用基于例子的布局来决定日期格式，
-	// 一般你只要使用 `time` 包中提供的布局常量就行了，但是你也可以实现自定义布局。
-	// 布局时间必须使用 `Mon Jan 2 15:04:05 MST 2006` 的格式，
-	// 来指定 格式化/解析给定时间/字符串 的布局。
-	// 时间一定要遵循：2006 为年，15 为小时，Monday 代表星期几等规则。
+	// `Format` 和 `Parse` 使用基于例子的布局来决定日期格式：
+	// 布局本身就是用目标格式写出的一个特殊的 *参考时间*
+	// `Mon Jan 2 15:04:05 MST 2006`。
+	// 把它的各个部分写成数字，正好是 `01/02 03:04:05PM '06 -0700`，
+	// 即 1 月、2 日、3（15）点、4 分、5 秒、06 年、时区 -7，
+	// 记住这个顺序，就能知道每个数字代表什么。
+	// 例如 2006 为年、01 为月、15 为 24 小时制的小时、
+	// 03 为 12 小时制的小时、Monday 代表星期几。
+	// 一般你只要使用 `time` 包中提供的布局常量就行了，
+	// 例如 `time.Kitchen`，但是你也可以实现自定义布局。
+	p(t.Format(time.Kitchen))
 	p(t.Format("3:04PM"))
 	p(t.Format("Mon Jan _2 15:04:05 2006"))
+	p(t.Format("2006-01-02 15:04:05"))
 	p(t.Format("2006-01-02T15:04:05.999999-07:00"))
 	form := "3 04 PM"
 	t2, e := time.Parse(form, "8 41 PM")
@@ -39,6 +56,33 @@ func main() {
 		t.Year(), t.Month(), t.Day(),
 		t.Hour(), t.Minute(), t.Second())
 
+	// `LoadLocation` 按照 IANA 时区数据库中的名称加载时区。
+	// `In` 返回同一时刻在另一个时区中的表示，
+	// 只是显示不同，它们仍然代表同一个时间点。
+	ny, e := time.LoadLocation("America/New_York")
+	if e != nil {
+		panic(e)
+	}
+	tny := t.In(ny)
+	p(tny.Format(time.RFC1123))
+	p("same instant:", tny.Equal(t))
+
+	// 如果字符串中没有时区信息，`Parse` 将其视为 UTC；
+	// `ParseInLocation` 则在给定的时区中解释它。
+	const local = "2006-01-02 15:04"
+	t3, e := time.Parse(local, "2014-04-15 14:00")
+	if e != nil {
+		panic(e)
+	}
+	p(t3)
+	t4, e := time.ParseInLocation(local,
+		"2014-04-15 14:00", ny)
+	if e != nil {
+		panic(e)
+	}
+	p(t4)
+	p("in UTC:", t4.UTC())
+
 	// 当输入的时间格式不正确时，`Parse` 会返回一个解析错误。
 	ansic := "Mon Jan _2 15:04:05 2006"
 	_, e = time.Parse(ansic, "8:41PM")
@@ -1,9 +1,17 @@
-$ go run time-formatting-parsing.go 
-2014-04-15T18:00:15-07:00
-2012-11-01 22:08:41 +0000 +0000
+$ go run time-formatting-parsing.go
+2014-04-15T18:00:15Z
+Thu, 01 Nov 2012 22:08:41 +0000
+6:00PM
 6:00PM
 Tue Apr 15 18:00:15 2014
-2014-04-15T18:00:15.161182-07:00
+2014-04-15 18:00:15
+2014-04-15T18:00:15.161182+00:00
 0000-01-01 20:41:00 +0000 UTC
 2014-04-15T18:00:15-00:00
-parsing time "8:41PM" as "Mon Jan _2 15:04:05 2006": ...
+Tue, 15 Apr 2014 14:00:15 EDT
+same instant: true
+2014-04-15 14:00:00 +0000 UTC
+2014-04-15 14:00:00 -0400 EDT
+in UTC: 2014-04-15 18:00:00 +0000 UTC
+parsing time "8:41PM" as "Mon Jan _2 15:04:05 2006":
+  cannot parse "8:41PM" as "Mon"
@@ -1,3 +1,5 @@
+// norun
+
 // Go 为时间（time）和时间段（duration）提供了大量的支持；这儿有是一些例子。
 
 package main
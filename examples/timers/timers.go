@@ -1,6 +1,11 @@
 // 我们经常需要在未来的某个时间点运行 Go 代码，或者每隔一定时间重复运行代码。
 // Go 内置的 _定时器_ 和 _打点器_ 特性让这些变得很简单。
 // 我们会先学习定时器，然后再学习[打点器](tickers)。
+//
+// Go 1.23 改变了定时器的两处行为（需要 `go.mod` 中的
+// `go` 版本不低于 1.23）：没有被引用的定时器即使还没有停止，
+// 也可以被垃圾回收；定时器的通道变成了同步的（无缓冲），
+// 因此 `Stop` 或 `Reset` 返回之后，不会再收到过期的旧值。
 
 package main
 
@@ -13,8 +18,8 @@ func main() {
 
 	// 定时器表示在未来某一时刻的独立事件。
 	// 你告诉定时器需要等待的时间，然后它将提供一个用于通知的通道。
-	// 这里的定时器将等待 2 秒。
-	timer1 := time.NewTimer(2 * time.Second)
+	// 这里的定时器将等待 200ms。
+	timer1 := time.NewTimer(200 * time.Millisecond)
 
 	// `<-timer1.C` 会一直阻塞，
 	// 直到定时器的通道 `C` 明确的发送了定时器失效的值。
@@ -24,7 +29,7 @@ func main() {
 	// 如果你需要的仅仅是单纯的等待，使用 `time.Sleep` 就够了。
 	// 使用定时器的原因之一就是，你可以在定时器触发之前将其取消。
 	// 例如这样。
-	timer2 := time.NewTimer(time.Second)
+	timer2 := time.NewTimer(100 * time.Millisecond)
 	go func() {
 		<-timer2.C
 		fmt.Println("Timer 2 fired")
@@ -35,5 +40,40 @@ func main() {
 	}
 
 	// 给 `timer2` 足够的时间来触发它，以证明它实际上已经停止了。
-	time.Sleep(2 * time.Second)
+	time.Sleep(200 * time.Millisecond)
+
+	// 在 Go 1.23 之前，定时器的通道有一个缓冲区。
+	// 如果定时器已经触发、但值还没有被接收，
+	// `Stop` 返回 `false`，而旧值仍然留在通道里，
+	// 所以 `Reset` 之前常常需要写 `if !t.Stop() { <-t.C }`
+	// 来“排空”通道。现在触发的时刻就是值被接收的时刻，
+	// 这里的 `Stop` 返回 `true`，通道中也没有旧值，
+	// 上面的排空写法反而会永远阻塞，不应该再使用。
+	timer3 := time.NewTimer(10 * time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	fmt.Println("Timer 3 stopped:", timer3.Stop())
+	select {
+	case <-timer3.C:
+		fmt.Println("Timer 3 stale value")
+	default:
+		fmt.Println("Timer 3 channel empty")
+	}
+
+	// `Reset` 让一个定时器重新开始计时。
+	timer3.Reset(10 * time.Millisecond)
+	<-timer3.C
+	fmt.Println("Timer 3 fired after Reset")
+
+	// `time.After` 是 `NewTimer(d).C` 的简写，
+	// 常用于在 `select` 中实现超时，参考[超时处理](timeouts)。
+	// 它无法被停止，在 Go 1.23 之前，定时器在触发之前不会被回收，
+	// 因此在频繁执行的循环中使用它会积累大量定时器；
+	// 现在不再被引用的定时器会被及时回收。
+	result := make(chan string)
+	select {
+	case r := <-result:
+		fmt.Println(r)
+	case <-time.After(50 * time.Millisecond):
+		fmt.Println("timeout")
+	}
 }
@@ -1,5 +1,11 @@
-# 第一个定时器将在程序开始后大约 2s 触发，
+# 第一个定时器将在程序开始后大约 200ms 触发，
 # 但是第二个定时器还未触发就停止了。
+# 第三个定时器被停止后，通道中没有残留的旧值，
+# 重置之后可以再次触发。
 $ go run timers.go
 Timer 1 fired
 Timer 2 stopped
+Timer 3 stopped: true
+Timer 3 channel empty
+Timer 3 fired after Reset
+timeout
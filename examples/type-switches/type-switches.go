@@ -0,0 +1,91 @@
+// 不包含任何方法的接口 `interface{}` 被称为 _空接口_，
+// 所有类型都实现了它，因此它可以保存任意类型的值。
+// 从 Go 1.18 开始，`any` 是 `interface{}` 的别名，两者完全相同。
+// 要使用空接口中保存的值，需要通过 _类型断言_ 或者
+// _类型开关_ 找出它的动态类型。[Switch](switch) 的例子中
+// 已经简单介绍过类型开关，这里我们更详细地看看它们。
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+type point struct {
+	X, Y int
+}
+
+// `point` 实现了 `fmt.Stringer` 接口。
+func (p point) String() string {
+	return fmt.Sprintf("(%d, %d)", p.X, p.Y)
+}
+
+// `describe` 使用类型开关，根据 `x` 的动态类型返回不同的描述。
+// 在每个只列出一个类型的分支中，`v` 就是那个类型的值，
+// 可以直接使用；列出多个类型的分支以及 `default` 分支中，
+// `v` 的类型和 `x` 一样，仍然是 `any`。
+func describe(x any) string {
+	switch v := x.(type) {
+	case nil:
+		// 只有 `x` 本身是 nil 接口值时，才会进入这个分支。
+		return "nil"
+	case int:
+		return fmt.Sprintf("int %d, doubled %d", v, v*2)
+	case string:
+		return fmt.Sprintf("string %q, len %d", v, len(v))
+	case point:
+		return fmt.Sprintf("point with X=%d", v.X)
+	case []int:
+		sum := 0
+		for _, n := range v {
+			sum += n
+		}
+		return fmt.Sprintf("%d ints, sum %d", len(v), sum)
+	case int8, int16:
+		return fmt.Sprintf("small int %v", v)
+
+	// 分支可以是接口类型：任何实现了 `error` 的值都会进入这里。
+	// 分支按顺序匹配，所以更具体的类型应该写在前面。
+	case error:
+		return "error: " + v.Error()
+	default:
+		return fmt.Sprintf("unexpected %T", v)
+	}
+}
+
+func main() {
+
+	values := []any{
+		42, "gopher", point{1, 2}, []int{1, 2, 3},
+		nil, int8(7), errors.New("boom"), 3.5,
+	}
+	for _, v := range values {
+		fmt.Println(describe(v))
+	}
+
+	// 只关心一种类型时，可以使用类型断言 `x.(T)`。
+	// 带有第二个返回值 `ok` 的形式不会失败：
+	// 如果 `x` 不是 `T` 类型，`ok` 是 `false`，
+	// 第一个返回值则是 `T` 的零值。
+	// 如果不接收 `ok`，断言失败时会引发 panic，例如下面的
+	// `x` 使用 `x.(int)` 会导致 panic: interface conversion:
+	// interface {} is string, not int。
+	// 所以除非能够确定值的类型，否则应该使用带 `ok` 的形式。
+	var x any = "hello"
+	s, ok := x.(string)
+	fmt.Printf("%q %v\n", s, ok)
+	n, ok := x.(int)
+	fmt.Println(n, ok)
+
+	// 断言的目标也可以是接口类型，
+	// 这可以用来检查一个值是否实现了某个接口，
+	// 例如判断它是否实现了 `fmt.Stringer`。
+	for _, v := range []any{point{3, 4}, 42} {
+		if str, ok := v.(fmt.Stringer); ok {
+			fmt.Println("Stringer:", str.String())
+		} else {
+			fmt.Printf("%T is not a Stringer\n", v)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+# 每个值都进入了与它的类型相对应的分支。
+$ go run type-switches.go
+int 42, doubled 84
+string "gopher", len 6
+point with X=1
+3 ints, sum 6
+nil
+small int 7
+error: boom
+unexpected float64
+"hello" true
+0 false
+Stringer: (3, 4)
+int is not a Stringer
@@ -0,0 +1,88 @@
+// `unsafe` 包提供了绕过 Go 类型系统的操作，
+// 可以查看类型在内存中的布局，或者在任意指针类型之间转换。
+// 正如它的名字所示，这些操作是*不安全*的：
+// 编译器无法再为你检查类型，错误的用法会悄无声息地破坏内存，
+// 而且使用 `unsafe` 的代码不受 Go 1 兼容性承诺的保护。
+// 绝大多数程序都不需要它，除非你确实清楚自己在做什么。
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"unsafe"
+)
+
+// 编译器会按照字段的对齐要求在结构体中插入填充（padding）。
+// 这两个结构体包含相同的字段，只是顺序不同。
+type padded struct {
+	a bool
+	b int64
+	c bool
+}
+
+type packed struct {
+	b int64
+	a bool
+	c bool
+}
+
+func main() {
+
+	// `unsafe.Sizeof` 返回一个值占用的字节数，
+	// `unsafe.Alignof` 返回它的对齐要求，
+	// `unsafe.Offsetof` 返回字段相对于结构体起始位置的偏移量。
+	// 它们都在编译期求值。注意，这些值与平台有关：
+	// 下面的输出是在 amd64、arm64 这样的 64 位平台上得到的，
+	// 而在 386、arm 这样的 32 位平台上，
+	// `int64` 只需要 4 字节对齐，得到的数值会更小。
+	var p padded
+	fmt.Println("padded size:", unsafe.Sizeof(p))
+	fmt.Println("align of b:", unsafe.Alignof(p.b))
+	fmt.Println("offsets:", unsafe.Offsetof(p.a),
+		unsafe.Offsetof(p.b), unsafe.Offsetof(p.c))
+
+	// 为了让 `b` 对齐到 8 字节，`a` 之后填充了 7 个字节，
+	// `c` 之后又填充了 7 个字节，使整个结构体的大小
+	// 是其对齐值的整数倍。把大的字段放在前面，
+	// 小的字段就能紧挨着排列，省下空间。
+	var q packed
+	fmt.Println("packed size:", unsafe.Sizeof(q))
+	fmt.Println("offsets:", unsafe.Offsetof(q.b),
+		unsafe.Offsetof(q.a), unsafe.Offsetof(q.c))
+
+	// `unsafe.Pointer` 可以和任意指针类型相互转换，
+	// 借此可以用另一种类型来解释同一块内存。
+	// 这里我们读取一个 `float64` 的二进制表示。
+	// 只有当两种类型大小相同、内存布局兼容时，这样的转换才是合法的。
+	// 使用 `unsafe.Pointer` 时还有一些必须遵守的规则：
+	// - 不要把 `unsafe.Pointer` 转换为 `uintptr` 后保存起来，
+	//   再转换回指针。`uintptr` 只是一个整数，
+	//   垃圾回收器不会把它当作引用，它指向的对象可能被回收或移动；
+	// - 指针运算（`unsafe.Add`）不能越过原对象的边界；
+	// - 通过转换后的指针访问内存时，必须满足目标类型的对齐要求。
+	// 完整的规则请参考 `unsafe.Pointer` 的文档，
+	// `go vet` 也能发现其中一部分错误用法。
+	f := 1.5
+	bits := *(*uint64)(unsafe.Pointer(&f))
+	fmt.Printf("bits: %#016x\n", bits)
+
+	// 当然，对于这个需求，标准库已经提供了安全的写法
+	// `math.Float64bits`，应该优先使用它。
+	fmt.Printf("bits: %#016x\n", math.Float64bits(f))
+
+	// `unsafe.Slice` 根据指向第一个元素的指针和长度构造一个切片，
+	// `unsafe.String` 以同样的方式构造一个字符串（Go 1.20 起）。
+	// 它们与原来的数据共享同一块内存，不会发生复制。
+	// 因此用 `unsafe.String` 构造字符串后，绝不能再修改底层的字节，
+	// 否则就违反了字符串不可变的约定。
+	arr := [5]byte{'g', 'o', 'p', 'h', 'r'}
+	s := unsafe.Slice(&arr[0], 3)
+	fmt.Println(s, len(s), cap(s))
+	str := unsafe.String(&arr[0], len(arr))
+	fmt.Println(str)
+
+	// `unsafe.StringData` 和 `unsafe.SliceData` 则是反过来，
+	// 返回字符串或切片底层数据的指针。
+	fmt.Println(unsafe.StringData(str) == &arr[0])
+}
@@ -0,0 +1,13 @@
+# 在 64 位平台上运行这个程序。可以看到，
+# 仅仅调整字段的顺序，结构体就从 24 字节缩小到了 16 字节。
+$ go run unsafe.go
+padded size: 24
+align of b: 8
+offsets: 0 8 16
+packed size: 16
+offsets: 0 8 9
+bits: 0x3ff8000000000000
+bits: 0x3ff8000000000000
+[103 111 112] 3 3
+gophr
+true
@@ -37,6 +37,10 @@ func main() {
 	fmt.Println(host)
 	fmt.Println(port)
 
+	// `Hostname` 和 `Port` 方法可以直接得到这两部分，
+	// 并且能正确处理 `[::1]:80` 这样的 IPv6 地址。
+	fmt.Println(u.Hostname(), u.Port())
+
 	// 这里我们提取路径和 `#` 后面的查询片段信息。
 	fmt.Println(u.Path)
 	fmt.Println(u.Fragment)
@@ -49,4 +53,36 @@ func main() {
 	m, _ := url.ParseQuery(u.RawQuery)
 	fmt.Println(m)
 	fmt.Println(m["k"][0])
+
+	// `Query` 方法是同样功能的快捷方式，它会忽略格式错误的参数。
+	// `url.Values` 的 `Get` 方法返回某个键的第一个值，
+	// 键不存在时返回空字符串。
+	q := u.Query()
+	fmt.Println(q.Get("k"), q.Has("x"))
+
+	// 反过来，我们也可以通过填充 `url.URL` 结构体来构建 URL，
+	// `String` 方法会负责对各个部分进行必要的转义。
+	b := url.URL{
+		Scheme:   "https",
+		Host:     "example.com",
+		Path:     "/go by",
+		Fragment: "top",
+	}
+
+	// 查询参数可以用 `url.Values` 来构建。
+	// `Encode` 会对键和值进行转义，并按键的字母顺序排列，
+	// 因此输出是确定的，而与添加参数的顺序无关。
+	params := url.Values{}
+	params.Set("q", "a&b")
+	params.Add("lang", "zh")
+	params.Add("lang", "en")
+	b.RawQuery = params.Encode()
+	fmt.Println(params.Encode())
+	fmt.Println(b.String())
+
+	// 单独转义字符串时，`PathEscape` 用于路径中的一段，
+	// 它会把空格转义为 `%20`；而 `QueryEscape` 用于查询参数，
+	// 它会把空格转义为 `+`，并且会转义 `/`、`&` 等字符。
+	fmt.Println(url.PathEscape("a b/c&d"))
+	fmt.Println(url.QueryEscape("a b/c&d"))
 }
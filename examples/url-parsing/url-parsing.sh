@@ -8,8 +8,14 @@ pass
 host.com:5432
 host.com
 5432
+host.com 5432
 /path
 f
 k=v
 map[k:[v]]
 v
+v false
+lang=zh&lang=en&q=a%26b
+https://example.com/go%20by?lang=zh&lang=en&q=a%26b#top
+a%20b%2Fc&d
+a+b%2Fc%26d
@@ -8,40 +8,63 @@ import (
 	"time"
 )
 
-// 每个协程都会运行该函数。
-func worker(id int) {
-	fmt.Printf("Worker %d starting\n", id)
-
-	// 睡眠一秒钟，以此来模拟耗时的任务。
-	time.Sleep(time.Second)
-	fmt.Printf("Worker %d done\n", id)
+// 每个协程都会运行该函数。它模拟一个耗时的任务，
+// 并返回一个结果。
+func worker(id int) int {
+	time.Sleep(10 * time.Millisecond)
+	return id * id
 }
 
 func main() {
 
 	// 这个 WaitGroup 用于等待这里启动的所有协程完成。
-	// 注意：如果 WaitGroup 显式传递到函数中，则应使用 *指针* 。
+	// WaitGroup 在第一次使用后就不能被复制，
+	// 复制得到的是一个独立的计数器，在副本上调用 `Done`
+	// 不会通知原来的 WaitGroup。所以如果需要将它传递到函数中，
+	// 应该使用 *指针*，`go vet` 也会检查出这类错误。
 	var wg sync.WaitGroup
 
+	// 每个协程把结果写入切片中属于自己的那个元素，
+	// 不同的协程写入不同的元素，所以不会发生数据竞争，
+	// 也不需要加锁。结果的顺序也因此是固定的。
+	results := make([]int, 5)
+
 	// 启动几个协程，并为其递增 WaitGroup 的计数器。
+	// 必须在启动协程*之前*调用 `Add`：如果在协程内部调用，
+	// `Wait` 可能在某些协程还没来得及调用 `Add` 时就已经返回了。
+	// 从 Go 1.22 开始，每次迭代都有自己的循环变量 `i`，
+	// 所以闭包捕获的值不会被后续的迭代覆盖。
 	for i := 1; i <= 5; i++ {
 		wg.Add(1)
-		// 避免在每个协程闭包中重复利用相同的 `i` 值
-		// 更多细节可以参考 [the FAQ](https://golang.org/doc/faq#closures_and_goroutines)
-		i := i
 
 		// 将 worker 调用包装在一个闭包中，可以确保通知 WaitGroup 此工作线程已完成。
 		// 这样，worker 线程本身就不必知道其执行中涉及的并发原语。
+		// 使用 `defer` 调用 `Done`，即使 worker 提前返回或者 panic，
+		// 计数器也会被递减；如果忘记调用 `Done`，`Wait` 将永远阻塞。
 		go func() {
 			defer wg.Done()
-			worker(i)
+			results[i-1] = worker(i)
 		}()
 	}
 
 	// 阻塞，直到 WaitGroup 计数器恢复为 0；
 	// 即所有协程的工作都已经完成。
 	wg.Wait()
+	fmt.Println("results:", results)
+
+	// Go 1.25 为 WaitGroup 添加了 `Go` 方法，
+	// 它在启动协程之前调用 `Add(1)`，并在函数返回后调用 `Done`，
+	// 从而一次性避免了上面提到的两个错误。
+	// 新的代码应该优先使用这种写法。
+	more := make([]int, 3)
+	for i := range more {
+		wg.Go(func() {
+			more[i] = worker(i + 6)
+		})
+	}
+	wg.Wait()
+	fmt.Println("more:", more)
 
 	// 请注意，WaitGroup 这种使用方式没有直观的办法传递来自 worker 的错误。
-	// 更高级的用例，请参见 [errgroup package](https://pkg.go.dev/golang.org/x/sync/errgroup)
+	// 更高级的用例，请参见 [errgroup](errgroup) 的例子。
 }
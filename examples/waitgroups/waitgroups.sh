@@ -1,13 +1,6 @@
+# 各个协程开启和完成的时间可能是不同的，
+# 但由于每个协程都把结果写入了固定的位置，
+# 打印出来的结果总是相同的。
 $ go run waitgroups.go
-Worker 5 starting
-Worker 3 starting
-Worker 4 starting
-Worker 1 starting
-Worker 2 starting
-Worker 4 done
-Worker 1 done
-Worker 2 done
-Worker 5 done
-Worker 3 done
-
-# 每次运行，各个协程开启和完成的时间可能是不同的。
\ No newline at end of file
+results: [1 4 9 16 25]
+more: [36 49 64]
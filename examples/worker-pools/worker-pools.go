@@ -4,17 +4,19 @@ package main
 
 import (
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 // 这是 worker 程序，我们会并发的运行多个 worker。
 // worker 将在 `jobs` 频道上接收工作，并在 `results` 上发送相应的结果。
-// 每个 worker 我们都会 sleep 一秒钟，以模拟一项昂贵的（耗时一秒钟的）任务。
-func worker(id int, jobs <-chan int, results chan<- int) {
+// 每个 worker 我们都会 sleep 一会，以模拟一项昂贵的（耗时较长的）任务。
+// 当 `jobs` 通道被关闭，并且其中的任务都被取走之后，
+// `range` 循环就会结束，worker 也随之退出。
+func worker(jobs <-chan int, results chan<- int) {
 	for j := range jobs {
-		fmt.Println("worker", id, "started  job", j)
-		time.Sleep(time.Second)
-		fmt.Println("worker", id, "finished job", j)
+		time.Sleep(100 * time.Millisecond)
 		results <- j * 2
 	}
 }
@@ -28,8 +30,14 @@ func main() {
 
 	// 这里启动了 3 个 worker，
 	// 初始是阻塞的，因为还没有传递任务。
+	// 我们使用 [WaitGroup](waitgroups) 来得知所有 worker 何时完成。
+	var wg sync.WaitGroup
 	for w := 1; w <= 3; w++ {
-		go worker(w, jobs, results)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			worker(jobs, results)
+		}()
 	}
 
 	// 这里我们发送 5 个 `jobs`，
@@ -39,10 +47,53 @@ func main() {
 	}
 	close(jobs)
 
+	// 所有 worker 退出之后，关闭 `results` 通道，
+	// 这样下面遍历 `results` 的循环才能结束。
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
 	// 最后，我们收集所有这些任务的返回值。
-	// 这也确保了所有的 worker 协程都已完成。
-	// 另一个等待多个协程的方法是使用[WaitGroup](waitgroups)。
-	for a := 1; a <= numJobs; a++ {
-		<-results
+	// 任务完成的顺序是不确定的，但它们的和是确定的。
+	sum := 0
+	for r := range results {
+		sum += r
+	}
+	fmt.Println("sum:", sum)
+
+	// 有时我们更愿意为每个任务启动一个协程，
+	// 但又需要限制同时运行的任务数量。
+	// 一个带缓冲的通道可以用作 *信号量（semaphore）*：
+	// 开始工作前向 `sem` 发送一个值，缓冲区满时发送会阻塞；
+	// 工作结束后再从中取出一个值，让出名额。
+	const limit = 2
+	sem := make(chan struct{}, limit)
+	var running, peak atomic.Int64
+	squares := make([]int, 6)
+	for i := range squares {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			// 记录同时运行的任务数的最大值。
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(50 * time.Millisecond)
+			running.Add(-1)
+
+			// 每个协程只写入属于自己的元素，因此不存在数据竞争。
+			squares[i] = i * i
+		}()
 	}
+	wg.Wait()
+	fmt.Println("squares:", squares)
+	fmt.Println("within limit:", peak.Load() <= limit)
 }
@@ -6,49 +6,105 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"path/filepath"
 )
 
-func check(e error) {
-	if e != nil {
-		panic(e)
+func main() {
+
+	// 与读文件的例子一样，我们在一个返回错误的函数中完成所有工作，
+	// 由 `main` 统一处理错误。
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
 	}
 }
 
-func main() {
+func run() error {
+
+	// 所有文件都写在一个临时目录中，函数返回前删除它。
+	dir, err := os.MkdirTemp("", "writing-files")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
 
 	// 开始！这里展示了如何写入一个字符串（或者只是一些字节）到一个文件。
+	// 如果文件不存在，`os.WriteFile` 会以给定的权限创建它，
+	// 否则会先清空它原有的内容。
+	path1 := filepath.Join(dir, "dat1")
 	d1 := []byte("hello\ngo\n")
-	err := os.WriteFile("/tmp/dat1", d1, 0644)
-	check(err)
+	if err := os.WriteFile(path1, d1, 0644); err != nil {
+		return err
+	}
+	fmt.Printf("wrote %d bytes\n", len(d1))
+
+	path2 := filepath.Join(dir, "dat2")
+	if err := writeFile(path2); err != nil {
+		return err
+	}
+
+	// 然后读取并打印写入的内容。
+	for _, path := range []string{path1, path2} {
+		dat, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s:\n%s", filepath.Base(path), dat)
+	}
+	return nil
+}
 
-	// 对于更细粒度的写入，先打开一个文件。
-	f, err := os.Create("/tmp/dat2")
-	check(err)
+// 对于更细粒度的写入，先打开一个文件。
+// 注意这里的返回值 `err` 是具名的，这样 `defer` 中的函数可以修改它。
+func writeFile(path string) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
 
 	// 打开文件后，一个习惯性的操作是：立即使用 defer 调用文件的 `Close`。
-	defer f.Close()
+	// 对于写入的文件，`Close` 也可能返回错误（例如数据没能写入硬盘），
+	// 所以不应该忽略它：如果此前没有出错，就将它作为函数的返回值。
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
 
 	// 您可以按期望的那样 `Write` 字节切片。
 	d2 := []byte{115, 111, 109, 101, 10}
 	n2, err := f.Write(d2)
-	check(err)
+	if err != nil {
+		return err
+	}
 	fmt.Printf("wrote %d bytes\n", n2)
 
 	// `WriteString` 也是可用的。
 	n3, err := f.WriteString("writes\n")
-	check(err)
+	if err != nil {
+		return err
+	}
 	fmt.Printf("wrote %d bytes\n", n3)
 
-	// 调用 `Sync` 将缓冲区的数据写入硬盘。
-	f.Sync()
-
 	// 与我们前面看到的带缓冲的 Reader 一样，`bufio` 还提供了的带缓冲的 Writer。
+	// 它先将数据写入内存中的缓冲区，减少对文件的系统调用次数。
 	w := bufio.NewWriter(f)
 	n4, err := w.WriteString("buffered\n")
-	check(err)
+	if err != nil {
+		return err
+	}
 	fmt.Printf("wrote %d bytes\n", n4)
 
+	// 此时数据还在缓冲区中，文件中只有前面写入的 12 个字节。
+	fmt.Printf("buffered %d bytes\n", w.Buffered())
+
 	// 使用 `Flush` 来确保，已将所有的缓冲操作应用于底层 writer。
-	w.Flush()
+	// 写入过程中发生的错误也会在这里返回，所以要检查它。
+	if err := w.Flush(); err != nil {
+		return err
+	}
 
+	// `Flush` 只是将数据交给了操作系统，操作系统可能仍将它们暂存在内存中。
+	// 调用 `Sync` 将数据真正写入硬盘，这对于不能丢失数据的场景很重要。
+	return f.Sync()
 }
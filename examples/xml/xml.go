@@ -5,13 +5,22 @@ package main
 import (
 	"encoding/xml"
 	"fmt"
+	"io"
+	"strings"
 )
 
-//  `Plant` 结构将被映射到 `XML` 。
+// `Plant` 结构将被映射到 `XML` 。
 // 与 `JSON` 示例类似，字段标签包含用于编码器和解码器的指令。
 // 这里我们使用了 `XML` 包的一些特性：
-//  `XMLName` 字段名称规定了该结构的 `XML` 元素名称；
-//  `id,attrr` 表示 `Id` 字段是一个 `XML` 属性，而不是嵌套元素。
+// `XMLName` 字段名称规定了该结构的 `XML` 元素名称；
+// `id,attr` 表示 `Id` 字段是一个 `XML` 属性，而不是嵌套元素。
+//
+// XML 标签的语法与 JSON 相似，但选项不同：
+// 名称之后的 `attr` 表示属性，`chardata` 表示元素的文本，
+// `innerxml` 表示未经解析的原始内容，`comment` 表示注释，
+// `omitempty` 同样省略零值，`-` 同样忽略这个字段。
+// 没有选项的字段默认被编码为子元素，
+// 而 `a>b` 形式的名称表示嵌套的子元素。
 type Plant struct {
 	XMLName xml.Name `xml:"plant"`
 	Id      int      `xml:"id,attr"`
@@ -58,4 +67,72 @@ func main() {
 
 	out, _ = xml.MarshalIndent(nesting, " ", "  ")
 	fmt.Println(string(out))
+
+	// `,chardata` 将字段映射为元素的文本内容，
+	// 这里元素既有属性，又有文本。
+	type Note struct {
+		XMLName xml.Name `xml:"note"`
+		Lang    string   `xml:"lang,attr"`
+		Text    string   `xml:",chardata"`
+	}
+	out, _ = xml.Marshal(Note{Lang: "zh", Text: "咖啡 & 茶"})
+	fmt.Println(string(out))
+
+	// `,innerxml` 保存元素内未经解析的原始 XML，
+	// 适合原样保留或者稍后再处理的内容。
+	type Raw struct {
+		Inner string `xml:",innerxml"`
+	}
+	var raw Raw
+	doc := `<plant><name>Tea</name><!-- x --></plant>`
+	err := xml.Unmarshal([]byte(doc), &raw)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("inner:", raw.Inner)
+
+	// 标签中的名称可以带有命名空间，写作 `"命名空间 名称"`。
+	// 解码时，文档中的前缀（例如 `g:`）会被解析为
+	// 对应的命名空间 URL，再与标签进行匹配。
+	const base = "http://base.google.com/ns/1.0"
+	type Item struct {
+		XMLName xml.Name `xml:"item"`
+		Title   string   `xml:"title"`
+		Price   string   `xml:"http://base.google.com/ns/1.0 price"`
+	}
+	doc = `<item xmlns:g="` + base + `">` +
+		`<title>Coffee</title>` +
+		`<g:price>9.99</g:price></item>`
+	var item Item
+	err = xml.Unmarshal([]byte(doc), &item)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%s: %s\n", item.Title, item.Price)
+
+	// 对于很大的文档，不必一次把它全部读入内存。
+	// `xml.NewDecoder` 逐个读取文档中的 *词法单元*，
+	// 遇到感兴趣的元素时，再用 `DecodeElement` 解码它。
+	doc = `<plants>` +
+		`<plant id="1"><name>Tea</name></plant>` +
+		`<plant id="2"><name>Cocoa</name></plant>` +
+		`</plants>`
+	d := xml.NewDecoder(strings.NewReader(doc))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			panic(err)
+		}
+		se, ok := tok.(xml.StartElement)
+		if !ok || se.Name.Local != "plant" {
+			continue
+		}
+		var p Plant
+		if err := d.DecodeElement(&p, &se); err != nil {
+			panic(err)
+		}
+		fmt.Println("streamed:", p.Id, p.Name)
+	}
 }
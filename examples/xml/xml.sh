@@ -27,3 +27,8 @@ Plant id=27, name=Coffee, origin=[Ethiopia Brazil]
      </child>
    </parent>
  </nesting>
+<note lang="zh">咖啡 &amp; 茶</note>
+inner: <name>Tea</name><!-- x -->
+Coffee: 9.99
+streamed: 1 Tea
+streamed: 2 Cocoa
@@ -0,0 +1,162 @@
+// Package coverage compares the examples of this translation against the
+// list of upstream English examples, and spots sources whose comments look
+// like they were left untranslated.
+package coverage
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"gobyexample/internal/site"
+)
+
+// Report is the outcome of comparing the local examples to the upstream
+// index. All lists hold example IDs, sorted.
+type Report struct {
+	Upstream []string
+	Present  []string
+	Missing  []string
+	Extra    []string
+}
+
+// Percent returns the share of upstream examples present locally.
+func (r *Report) Percent() float64 {
+	if len(r.Upstream) == 0 {
+		return 100
+	}
+	return 100 * float64(len(r.Present)) / float64(len(r.Upstream))
+}
+
+// ReadIndex reads a list of upstream example names, one per line, and
+// returns their IDs. Blank lines and lines starting with "#" are ignored.
+// An example this translation keeps under another ID is listed as
+// "Upstream Name->local-id", and that local ID is returned for it.
+func ReadIndex(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var ids []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, local, _ := strings.Cut(line, "->")
+		if local = strings.TrimSpace(local); local != "" {
+			ids = append(ids, local)
+			continue
+		}
+		ids = append(ids, site.ExampleID(strings.TrimSpace(name)))
+	}
+	return ids, scanner.Err()
+}
+
+// LocalExamples returns the IDs of the example directories under
+// root/examples.
+func LocalExamples(root string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(root, "examples", "*"))
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, path := range paths {
+		if fi, err := os.Stat(path); err == nil && fi.IsDir() {
+			ids = append(ids, filepath.Base(path))
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// Compare reports which upstream examples exist locally, which are missing
+// and which local examples have no upstream counterpart.
+func Compare(upstream, local []string) *Report {
+	r := &Report{Upstream: sorted(upstream)}
+	have := make(map[string]bool)
+	for _, id := range local {
+		have[id] = true
+	}
+	known := make(map[string]bool)
+	for _, id := range r.Upstream {
+		known[id] = true
+		if have[id] {
+			r.Present = append(r.Present, id)
+		} else {
+			r.Missing = append(r.Missing, id)
+		}
+	}
+	for _, id := range sorted(local) {
+		if !known[id] {
+			r.Extra = append(r.Extra, id)
+		}
+	}
+	return r
+}
+
+func sorted(ids []string) []string {
+	ids = append([]string(nil), ids...)
+	sort.Strings(ids)
+	return ids
+}
+
+// IsCJK reports whether r is a Chinese character or CJK punctuation, which
+// translated comments are expected to contain.
+func IsCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) ||
+		(r >= 0x3000 && r <= 0x303f) || // CJK symbols and punctuation
+		(r >= 0xff00 && r <= 0xffef) // halfwidth and fullwidth forms
+}
+
+// commentPat matches comment lines, capturing their text. Directives such
+// as `//go:embed` have no space after the slashes and are not matched.
+var commentPat = regexp.MustCompile(`^\s*//\s(.*)$`)
+
+// markerPat matches the marker comments read by the tooling, which are
+// never translated.
+var markerPat = regexp.MustCompile(`^// (norun|noplay)\s*$`)
+
+// Translated returns how many of the comment lines of a Go source contain
+// CJK characters, out of how many comment lines it has.
+func Translated(src string) (cjk, total int) {
+	for _, line := range strings.Split(src, "\n") {
+		if markerPat.MatchString(line) {
+			continue
+		}
+		m := commentPat.FindStringSubmatch(line)
+		if m == nil || strings.TrimSpace(m[1]) == "" {
+			continue
+		}
+		total++
+		if strings.IndexFunc(m[1], IsCJK) >= 0 {
+			cjk++
+		}
+	}
+	return cjk, total
+}
+
+// TranslatedDir sums Translated over the Go sources of an example
+// directory.
+func TranslatedDir(dir string) (cjk, total int, err error) {
+	sources, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, source := range sources {
+		src, err := os.ReadFile(source)
+		if err != nil {
+			return 0, 0, err
+		}
+		c, t := Translated(string(src))
+		cjk += c
+		total += t
+	}
+	return cjk, total, nil
+}
@@ -0,0 +1,87 @@
+package coverage
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadIndex(t *testing.T) {
+	ids, err := ReadIndex(filepath.Join("testdata", "index.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"hello-world", "if-else", "time-formatting-parsing", "embedding"}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("ReadIndex = %q; want %q", ids, want)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	upstream, err := ReadIndex(filepath.Join("testdata", "index.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	local, err := LocalExamples("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := Compare(upstream, local)
+	// The renamed example counts as present, not as missing and extra.
+	if want := []string{"embedding", "hello-world", "if-else"}; !reflect.DeepEqual(r.Present, want) {
+		t.Errorf("Present = %q; want %q", r.Present, want)
+	}
+	if want := []string{"time-formatting-parsing"}; !reflect.DeepEqual(r.Missing, want) {
+		t.Errorf("Missing = %q; want %q", r.Missing, want)
+	}
+	if want := []string{"local-only"}; !reflect.DeepEqual(r.Extra, want) {
+		t.Errorf("Extra = %q; want %q", r.Extra, want)
+	}
+	if p := r.Percent(); p != 75 {
+		t.Errorf("Percent = %v; want 3/4", p)
+	}
+}
+
+func TestIsCJK(t *testing.T) {
+	var tests = []struct {
+		r    rune
+		want bool
+	}{
+		{'中', true},
+		{'。', true},
+		{'，', true},
+		{'“', false},
+		{'a', false},
+		{'`', false},
+		{'é', false},
+	}
+
+	for _, tt := range tests {
+		if got := IsCJK(tt.r); got != tt.want {
+			t.Errorf("IsCJK(%q) = %v; want %v", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestTranslatedDir(t *testing.T) {
+	var tests = []struct {
+		example    string
+		cjk, total int
+	}{
+		// The marker, the directive and code are not comments.
+		{"hello-world", 2, 2},
+		// Empty comment lines don't count.
+		{"if-else", 1, 4},
+	}
+
+	for _, tt := range tests {
+		dir := filepath.Join("testdata", "examples", tt.example)
+		cjk, total, err := TranslatedDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cjk != tt.cjk || total != tt.total {
+			t.Errorf("TranslatedDir(%s) = %d, %d; want %d, %d", tt.example, cjk, total, tt.cjk, tt.total)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+// 英文版中这个例子叫做 Struct Embedding。
+
+package main
+
+func main() {}
@@ -0,0 +1,14 @@
+// norun
+
+// 我们的第一个程序将打印传说中的“hello world”消息。
+
+package main
+
+import "fmt"
+
+//go:generate echo skipped
+
+func main() {
+	// 打印 `hello world`。
+	fmt.Println("hello world")
+}
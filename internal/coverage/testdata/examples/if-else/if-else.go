@@ -0,0 +1,19 @@
+// Branching with `if` and `else` in Go is
+// straight-forward.
+
+package main
+
+import "fmt"
+
+func main() {
+	// Here's a basic example.
+	if 7%2 == 0 {
+		fmt.Println("7 is even")
+	}
+
+	// 你可以不要 `else` 只用 `if` 语句。
+	if 8%4 == 0 {
+		fmt.Println("8 is divisible by 4")
+	}
+	//
+}
@@ -0,0 +1,5 @@
+// 这个例子只存在于中文版中。
+
+package main
+
+func main() {}
@@ -0,0 +1,184 @@
+// Package harness runs the programs under examples/ and checks what they print
+// against the golden expected-output.txt committed next to each of them.
+package harness
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// GoldenFile is the name of the file, next to an example's sources, holding
+// the output the example is expected to print.
+const GoldenFile = "expected-output.txt"
+
+// DurationPlaceholder stands, within golden files, for the timings go test
+// reports for each test and for the package as a whole.
+const DurationPlaceholder = "{{DURATION}}"
+
+// TempPlaceholder stands in, within golden files, for any path under the OS
+// temp directory, since those usually carry randomly generated names.
+const TempPlaceholder = "{{TEMP}}"
+
+// Timeout bounds how long a single example is allowed to run.
+var Timeout = 2 * time.Minute
+
+// noRunPat matches the marker comment excluding an example from being run,
+// typically because its output is not deterministic.
+var noRunPat = regexp.MustCompile(`(?m)^// norun\s*$`)
+
+var durationPat = regexp.MustCompile(`(?m)^(ok  \t\S+\t|\s*--- (?:PASS|FAIL|SKIP): .* \()\d+\.\d+s`)
+
+var tempPat = regexp.MustCompile(regexp.QuoteMeta(filepath.Clean(os.TempDir())+string(filepath.Separator)) + `\S*`)
+
+// Example is a single directory under examples/.
+type Example struct {
+	Name  string
+	Dir   string
+	NoRun bool
+
+	// Test is set for examples made of tests, which are run with go test
+	// instead of go run.
+	Test bool
+}
+
+// Examples returns every example under root/examples that has Go sources,
+// sorted by name.
+func Examples(root string) ([]*Example, error) {
+	dirs, err := filepath.Glob(filepath.Join(root, "examples", "*"))
+	if err != nil {
+		return nil, err
+	}
+	var examples []*Example
+	for _, dir := range dirs {
+		sources, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			return nil, err
+		}
+		if len(sources) == 0 {
+			continue
+		}
+		example := &Example{Name: filepath.Base(dir), Dir: dir}
+		for _, source := range sources {
+			if strings.HasSuffix(source, "_test.go") {
+				example.Test = true
+			}
+			src, err := os.ReadFile(source)
+			if err != nil {
+				return nil, err
+			}
+			if noRunPat.Match(src) {
+				example.NoRun = true
+			}
+		}
+		examples = append(examples, example)
+	}
+	sort.Slice(examples, func(i, j int) bool {
+		return examples[i].Name < examples[j].Name
+	})
+	return examples, nil
+}
+
+// Command returns the go command running the example, or its tests for a
+// Test example, from within the example's directory.
+func (e *Example) Command(ctx context.Context) *exec.Cmd {
+	args := []string{"run", "."}
+	if e.Test {
+		args = []string{"test", "-v"}
+	}
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = e.Dir
+	return cmd
+}
+
+// Run compiles and runs the example, or its tests for a Test example,
+// returning its normalized combined stdout and stderr.
+func (e *Example) Run() (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout)
+	defer cancel()
+	cmd := e.Command(ctx)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%s: %v\n%s", e.Name, err, out.String())
+	}
+	return Normalize(out.String()), nil
+}
+
+// Golden returns the expected output committed for the example.
+func (e *Example) Golden() (string, error) {
+	dat, err := os.ReadFile(filepath.Join(e.Dir, GoldenFile))
+	if err != nil {
+		return "", err
+	}
+	return strings.ReplaceAll(string(dat), "\r\n", "\n"), nil
+}
+
+// Bless runs the example and records its output as the expected one,
+// returning that output.
+func (e *Example) Bless() (string, error) {
+	out, err := e.Run()
+	if err != nil {
+		return "", err
+	}
+	return out, os.WriteFile(filepath.Join(e.Dir, GoldenFile), []byte(out), 0644)
+}
+
+// Normalize rewrites the parts of an example's output that legitimately
+// change from run to run into the placeholders used by golden files.
+func Normalize(out string) string {
+	out = strings.ReplaceAll(out, "\r\n", "\n")
+	out = durationPat.ReplaceAllString(out, "${1}"+DurationPlaceholder)
+	return tempPat.ReplaceAllString(out, TempPlaceholder)
+}
+
+// Diff returns a line-by-line diff of want and got, with removed lines
+// prefixed by "-" and added lines by "+".
+func Diff(want, got string) string {
+	a := strings.Split(want, "\n")
+	b := strings.Split(got, "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var buf strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			fmt.Fprintf(&buf, " %s\n", a[i])
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			fmt.Fprintf(&buf, "-%s\n", a[i])
+			i++
+		default:
+			fmt.Fprintf(&buf, "+%s\n", b[j])
+			j++
+		}
+	}
+	return buf.String()
+}
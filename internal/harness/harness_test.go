@@ -0,0 +1,203 @@
+package harness
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// root is the repository root, relative to this package.
+var root = filepath.Join("..", "..")
+
+var update = flag.Bool("update", false, "rewrite golden files with the examples' current output")
+
+func TestExamples(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping examples in short mode")
+	}
+	examples, err := Examples(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range examples {
+		e := e
+		t.Run(e.Name, func(t *testing.T) {
+			if e.NoRun {
+				t.Skip("marked norun")
+			}
+			t.Parallel()
+			check(t, e, *update)
+		})
+	}
+}
+
+// check compares the example's output against its golden file or, when
+// update is set, rewrites the golden file with that output instead.
+func check(t *testing.T, e *Example, update bool) {
+	t.Helper()
+	if update {
+		if _, err := e.Bless(); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := e.Golden()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := e.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("output mismatch (-want +got):\n%s", Diff(want, got))
+	}
+}
+
+// scratch lays out a repository holding a single example with the given
+// source, and returns that example.
+func scratch(t *testing.T, src string) *Example {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":                  "module scratch\n\ngo 1.21\n",
+		"examples/hello/hello.go": src,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	examples, err := Examples(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(examples) != 1 {
+		t.Fatalf("Examples = %v; want the hello example only", examples)
+	}
+	return examples[0]
+}
+
+const helloSrc = `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("hello")
+}
+`
+
+func TestBless(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go run in short mode")
+	}
+	e := scratch(t, helloSrc)
+	if _, err := e.Golden(); err == nil {
+		t.Fatal("Golden succeeded before Bless")
+	}
+	out, err := e.Bless()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "hello\n" {
+		t.Errorf("Bless = %q; want %q", out, "hello\n")
+	}
+	want, err := e.Golden()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := e.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("output mismatch after Bless (-want +got):\n%s", Diff(want, got))
+	}
+
+	// Once the example changes, its output no longer matches.
+	src := strings.Replace(helloSrc, `"hello"`, `"goodbye"`, 1)
+	if err := os.WriteFile(filepath.Join(e.Dir, "hello.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err = e.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := Diff(want, got); diff != "-hello\n+goodbye\n \n" {
+		t.Errorf("Diff = %q", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go run in short mode")
+	}
+	e := scratch(t, `package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	fmt.Println("tmp:", filepath.Join(os.TempDir(), "x123"))
+}
+`)
+	golden := filepath.Join(e.Dir, GoldenFile)
+	if err := os.WriteFile(golden, []byte("stale\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	check(t, e, true)
+	dat, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "tmp: " + TempPlaceholder + "\n"; string(dat) != want {
+		t.Errorf("updated golden = %q; want %q", dat, want)
+	}
+
+	// Without update, the freshly written golden file matches.
+	check(t, e, false)
+}
+
+func TestNormalize(t *testing.T) {
+	tmp := filepath.Join(os.TempDir(), "sample610887201")
+	got := Normalize("Temp file name: " + tmp + "\r\nTemp dir name: /tmpdir\n")
+	want := "Temp file name: " + TempPlaceholder + "\nTemp dir name: /tmpdir\n"
+	if got != want {
+		t.Errorf("Normalize = %q; want %q", got, want)
+	}
+}
+
+func TestNormalizeDurations(t *testing.T) {
+	got := Normalize("--- PASS: TestA (0.00s)\n    --- PASS: TestA/x_1 (1.25s)\nPASS\nok  \texamples/a\t0.023s\n")
+	want := "--- PASS: TestA ({{DURATION}})\n    --- PASS: TestA/x_1 ({{DURATION}})\nPASS\nok  \texamples/a\t{{DURATION}}\n"
+	if got != want {
+		t.Errorf("Normalize = %q; want %q", got, want)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	var tests = []struct {
+		want, got string
+		diff      string
+	}{
+		{"a\nb\n", "a\nb\n", " a\n b\n \n"},
+		{"a\nb\nc", "a\nc", " a\n-b\n c\n"},
+		{"a\nc", "a\nb\nc", " a\n+b\n c\n"},
+		{"a\nb", "a\nx", " a\n-b\n+x\n"},
+	}
+
+	for _, tt := range tests {
+		if diff := Diff(tt.want, tt.got); diff != tt.diff {
+			t.Errorf("Diff(%q, %q) = %q; want %q", tt.want, tt.got, diff, tt.diff)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package harness
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gobyexample/internal/stripcomments"
+)
+
+// ExceptionsFile is the name of the file, at the repository root, listing
+// the go vet checks individual examples are allowed to fail.
+const ExceptionsFile = "vet-exceptions.txt"
+
+// GofmtCheck is the Check of findings reported by gofmt rather than by one
+// of go vet's analyzers.
+const GofmtCheck = "gofmt"
+
+// Finding is a single problem go vet or gofmt reports about an example.
+type Finding struct {
+	Example string
+
+	// Pos locates the problem, relative to the repository root, as
+	// file:line:col, or just the file for sources gofmt would reformat.
+	Pos string
+
+	// Check is the name of the vet analyzer reporting the problem, or
+	// GofmtCheck.
+	Check   string
+	Message string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s (%s)", f.Pos, f.Message, f.Check)
+}
+
+// posPat matches the file:line:col: message lines the go command and gofmt
+// print for errors, such as sources that don't compile.
+var posPat = regexp.MustCompile(`^(\S+\.go):\d+:\d+: `)
+
+// Vet runs go vet over every example under root and returns what it reports.
+// Packages that fail to compile are reported under the "compile" check.
+func Vet(root string) ([]Finding, error) {
+	cmd := exec.Command("go", "vet", "-json", "./examples/...")
+	cmd.Dir = root
+	out, runErr := cmd.CombinedOutput()
+
+	// The JSON objects, one per package, are interleaved with "# package"
+	// headers and, for packages that don't compile, their errors prefixed
+	// by "vet: ".
+	var findings []Finding
+	var objects bytes.Buffer
+	for _, line := range strings.SplitAfter(string(out), "\n") {
+		trimmed := strings.TrimPrefix(strings.TrimSpace(line), "vet: ")
+		switch {
+		case strings.HasPrefix(trimmed, "#"):
+		case posPat.MatchString(trimmed):
+			findings = append(findings, finding(root, "compile", trimmed, ""))
+		default:
+			objects.WriteString(line)
+		}
+	}
+
+	type diagnostic struct {
+		Posn    string `json:"posn"`
+		Message string `json:"message"`
+	}
+	dec := json.NewDecoder(&objects)
+	for {
+		var pkgs map[string]map[string]json.RawMessage
+		if err := dec.Decode(&pkgs); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("parsing go vet output: %v\n%s", err, out)
+		}
+		for _, analyzers := range pkgs {
+			for analyzer, raw := range analyzers {
+				// An analyzer that failed reports an object with
+				// an error instead of a list of diagnostics.
+				var diags []diagnostic
+				if err := json.Unmarshal(raw, &diags); err != nil {
+					return nil, fmt.Errorf("go vet %s: %s", analyzer, raw)
+				}
+				for _, d := range diags {
+					findings = append(findings, finding(root, analyzer, d.Posn, d.Message))
+				}
+			}
+		}
+	}
+	if runErr != nil && len(findings) == 0 {
+		return nil, fmt.Errorf("go vet: %v\n%s", runErr, out)
+	}
+	sortFindings(findings)
+	return findings, nil
+}
+
+// Gofmt runs gofmt -l over the examples under root, reporting each source
+// it would reformat and each one it fails to parse.
+func Gofmt(root string) ([]Finding, error) {
+	cmd := exec.Command("gofmt", "-l", "examples")
+	cmd.Dir = root
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	runErr := cmd.Run()
+
+	var findings []Finding
+	sc := bufio.NewScanner(&stdout)
+	for sc.Scan() {
+		// The sources written by tools/stripcomments aren't committed.
+		if filepath.Base(sc.Text()) == stripcomments.OutputName {
+			continue
+		}
+		findings = append(findings, finding(root, GofmtCheck, sc.Text(), "not gofmt-clean, run gofmt -w"))
+	}
+	sc = bufio.NewScanner(&stderr)
+	for sc.Scan() {
+		if posPat.MatchString(sc.Text()) {
+			findings = append(findings, finding(root, GofmtCheck, sc.Text(), ""))
+		}
+	}
+	if runErr != nil && len(findings) == 0 {
+		return nil, fmt.Errorf("gofmt: %v\n%s", runErr, stderr.String())
+	}
+	sortFindings(findings)
+	return findings, nil
+}
+
+// finding returns the Finding for pos, a file position possibly followed by
+// ": message" as compilers print them.
+func finding(root, check, pos, message string) Finding {
+	if message == "" {
+		if m := posPat.FindString(pos); m != "" {
+			pos, message = strings.TrimSuffix(m, ": "), pos[len(m):]
+		}
+	}
+	if filepath.IsAbs(pos) {
+		if abs, err := filepath.Abs(root); err == nil {
+			if rel, err := filepath.Rel(abs, pos); err == nil && !strings.HasPrefix(rel, "..") {
+				pos = rel
+			}
+		}
+	}
+	pos = filepath.ToSlash(pos)
+	f := Finding{Pos: pos, Check: check, Message: message}
+	if parts := strings.Split(pos, "/"); len(parts) > 2 && parts[0] == "examples" {
+		f.Example = parts[1]
+	}
+	return f
+}
+
+func sortFindings(findings []Finding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Pos < findings[j].Pos
+	})
+}
+
+// Exceptions maps example names to the vet analyzers whose findings about
+// that example are accepted, usually because the example demonstrates the
+// very thing the analyzer warns about.
+type Exceptions map[string]map[string]bool
+
+// ParseExceptions reads exceptions, one "example analyzer" pair per line.
+// Blank lines and anything following a "#" are ignored.
+func ParseExceptions(r io.Reader) (Exceptions, error) {
+	x := make(Exceptions)
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line, _, _ := strings.Cut(sc.Text(), "#")
+		fields := strings.Fields(line)
+		switch len(fields) {
+		case 0:
+			continue
+		case 2:
+		default:
+			return nil, fmt.Errorf("line %d: want \"example analyzer\", got %q", n, strings.TrimSpace(line))
+		}
+		if fields[1] == GofmtCheck {
+			return nil, fmt.Errorf("line %d: gofmt findings can't be excepted", n)
+		}
+		if x[fields[0]] == nil {
+			x[fields[0]] = make(map[string]bool)
+		}
+		x[fields[0]][fields[1]] = true
+	}
+	return x, sc.Err()
+}
+
+// ReadExceptions reads the exceptions file at root. A missing file means
+// there are no exceptions.
+func ReadExceptions(root string) (Exceptions, error) {
+	f, err := os.Open(filepath.Join(root, ExceptionsFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return Exceptions{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	x, err := ParseExceptions(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", ExceptionsFile, err)
+	}
+	return x, nil
+}
+
+// Allows reports whether f is one of the accepted findings.
+func (x Exceptions) Allows(f Finding) bool {
+	return x[f.Example][f.Check]
+}
+
+// Filter returns the findings x doesn't allow.
+func (x Exceptions) Filter(findings []Finding) []Finding {
+	var kept []Finding
+	for _, f := range findings {
+		if !x.Allows(f) {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
+
+// Report formats findings grouped by example, in the order the examples
+// first appear.
+func Report(findings []Finding) string {
+	var order []string
+	byExample := make(map[string][]Finding)
+	for _, f := range findings {
+		if _, ok := byExample[f.Example]; !ok {
+			order = append(order, f.Example)
+		}
+		byExample[f.Example] = append(byExample[f.Example], f)
+	}
+	var buf strings.Builder
+	for _, name := range order {
+		label := name
+		if label == "" {
+			label = "(outside examples)"
+		}
+		fmt.Fprintf(&buf, "%s:\n", label)
+		for _, f := range byExample[name] {
+			fmt.Fprintf(&buf, "\t%s\n", f)
+		}
+	}
+	return buf.String()
+}
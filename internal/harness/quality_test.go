@@ -0,0 +1,125 @@
+package harness
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestQuality(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go vet in short mode")
+	}
+	x, err := ReadExceptions(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vet, err := Vet(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found := x.Filter(vet); len(found) > 0 {
+		t.Errorf("go vet reports, and %s doesn't allow:\n%s", ExceptionsFile, Report(found))
+	}
+	gofmt, err := Gofmt(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gofmt) > 0 {
+		t.Errorf("gofmt reports:\n%s", Report(gofmt))
+	}
+}
+
+func TestVetAndGofmt(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go vet in short mode")
+	}
+	e := scratch(t, `package main
+
+import "fmt"
+
+func main() {
+	fmt.Printf("%d\n", "hello")
+    fmt.Println()
+}
+`)
+	dir := filepath.Join(e.Dir, "..", "..")
+	vet, err := Vet(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vet) != 1 || vet[0].Example != "hello" || vet[0].Check != "printf" || !strings.HasPrefix(vet[0].Pos, "examples/hello/hello.go:6:") {
+		t.Errorf("Vet = %v; want a printf finding on line 6 of hello", vet)
+	}
+	gofmt, err := Gofmt(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Finding{{Example: "hello", Pos: "examples/hello/hello.go", Check: GofmtCheck, Message: "not gofmt-clean, run gofmt -w"}}
+	if !reflect.DeepEqual(gofmt, want) {
+		t.Errorf("Gofmt = %v; want %v", gofmt, want)
+	}
+
+	// Examples that don't compile are reported as well.
+	src := "package main\n\nfunc main() {\n\tundefined()\n}\n"
+	if err := os.WriteFile(filepath.Join(e.Dir, "hello.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	vet, err = Vet(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []Finding{{Example: "hello", Pos: "examples/hello/hello.go:4:2", Check: "compile", Message: "undefined: undefined"}}
+	if !reflect.DeepEqual(vet, want) {
+		t.Errorf("Vet = %v; want %v", vet, want)
+	}
+}
+
+func TestParseExceptions(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", ExceptionsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	x, err := ParseExceptions(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Exceptions{
+		"panic":            {"unreachable": true},
+		"struct-embedding": {"composites": true, "copylocks": true},
+	}
+	if !reflect.DeepEqual(x, want) {
+		t.Errorf("ParseExceptions = %v; want %v", x, want)
+	}
+
+	findings := []Finding{
+		{Example: "panic", Check: "unreachable"},
+		{Example: "panic", Check: "printf"},
+		{Example: "values", Check: "composites"},
+	}
+	if got := x.Filter(findings); !reflect.DeepEqual(got, findings[1:]) {
+		t.Errorf("Filter = %v; want %v", got, findings[1:])
+	}
+
+	for _, bad := range []string{"panic\n", "panic unreachable extra\n", "values gofmt\n"} {
+		if _, err := ParseExceptions(strings.NewReader("# ok\n" + bad)); err == nil || !strings.HasPrefix(err.Error(), "line 2:") {
+			t.Errorf("ParseExceptions(%q) error = %v; want one for line 2", bad, err)
+		}
+	}
+}
+
+func TestReport(t *testing.T) {
+	got := Report([]Finding{
+		{Example: "b", Pos: "examples/b/b.go:1:1", Check: "printf", Message: "bad format"},
+		{Example: "a", Pos: "examples/a/a.go", Check: GofmtCheck, Message: "not gofmt-clean"},
+		{Example: "b", Pos: "examples/b/b.go:9:2", Check: "unreachable", Message: "unreachable code"},
+	})
+	want := "b:\n\texamples/b/b.go:1:1: bad format (printf)\n\texamples/b/b.go:9:2: unreachable code (unreachable)\n" +
+		"a:\n\texamples/a/a.go: not gofmt-clean (gofmt)\n"
+	if got != want {
+		t.Errorf("Report = %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,64 @@
+package harness
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AmbiguousError is returned by Resolve when a name matches several
+// examples equally well.
+type AmbiguousError struct {
+	Name       string
+	Candidates []string
+}
+
+func (e *AmbiguousError) Error() string {
+	return fmt.Sprintf("%q is ambiguous, it could be any of: %s", e.Name, strings.Join(e.Candidates, ", "))
+}
+
+// Resolve finds the example a possibly partial name refers to. In order of
+// preference, the name matches an example exactly, as a prefix of its name,
+// as a substring, or as a subsequence of its characters ("tmpl" for
+// "text-templates"). When several examples match at the best level found,
+// the error is an *AmbiguousError listing them.
+func Resolve(examples []*Example, name string) (*Example, error) {
+	name = strings.ToLower(name)
+	matchers := []func(string) bool{
+		func(s string) bool { return s == name },
+		func(s string) bool { return strings.HasPrefix(s, name) },
+		func(s string) bool { return strings.Contains(s, name) },
+		func(s string) bool { return isSubsequence(name, s) },
+	}
+	for _, match := range matchers {
+		var found []*Example
+		for _, e := range examples {
+			if match(e.Name) {
+				found = append(found, e)
+			}
+		}
+		switch len(found) {
+		case 0:
+			continue
+		case 1:
+			return found[0], nil
+		}
+		err := &AmbiguousError{Name: name}
+		for _, e := range found {
+			err.Candidates = append(err.Candidates, e.Name)
+		}
+		return nil, err
+	}
+	return nil, fmt.Errorf("no example matches %q", name)
+}
+
+// isSubsequence reports whether the characters of sub appear in s, in order.
+func isSubsequence(sub, s string) bool {
+	for _, r := range sub {
+		i := strings.IndexRune(s, r)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(string(r)):]
+	}
+	return true
+}
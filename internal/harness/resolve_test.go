@@ -0,0 +1,49 @@
+package harness
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	var examples []*Example
+	for _, name := range []string{"select", "select-patterns", "text-templates", "timers", "time", "tickers"} {
+		examples = append(examples, &Example{Name: name})
+	}
+
+	var tests = []struct {
+		name       string
+		want       string
+		candidates []string
+	}{
+		// An exact match wins over longer names sharing its prefix.
+		{"select", "select", nil},
+		{"time", "time", nil},
+		{"Select-P", "select-patterns", nil},
+		{"templ", "text-templates", nil},
+		{"tmpl", "text-templates", nil},
+		{"ti", "", []string{"timers", "time", "tickers"}},
+		{"ers", "", []string{"timers", "tickers"}},
+		{"zzz", "", nil},
+	}
+
+	for _, tt := range tests {
+		e, err := Resolve(examples, tt.name)
+		var amb *AmbiguousError
+		switch {
+		case tt.want != "":
+			if err != nil || e.Name != tt.want {
+				t.Errorf("Resolve(%q) = %v, %v; want %s", tt.name, e, err, tt.want)
+			}
+		case tt.candidates != nil:
+			if !errors.As(err, &amb) || !reflect.DeepEqual(amb.Candidates, tt.candidates) {
+				t.Errorf("Resolve(%q) error = %v; want candidates %q", tt.name, err, tt.candidates)
+			}
+		default:
+			if err == nil || errors.As(err, &amb) {
+				t.Errorf("Resolve(%q) error = %v; want no match", tt.name, err)
+			}
+		}
+	}
+}
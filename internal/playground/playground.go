@@ -0,0 +1,117 @@
+// Package playground shares example sources on the Go Playground and keeps
+// track of the resulting share keys in each example's .hash file.
+//
+// A .hash file holds two lines: the SHA-1 of the example's Go source, and
+// the Playground key it was shared under. An example is only shared again
+// once its source no longer matches the recorded hash.
+package playground
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultEndpoint is the Playground's share endpoint.
+const DefaultEndpoint = "https://play.golang.org/share"
+
+// noPlayPat matches the marker comment keeping an example off the
+// Playground, typically because it can't run in its sandbox.
+var noPlayPat = regexp.MustCompile(`(?m)^// noplay\s*$`)
+
+// NoPlay reports whether the source carries the `// noplay` marker.
+func NoPlay(code string) bool {
+	return noPlayPat.MatchString(code)
+}
+
+// SourceHash returns the hash recorded for the source in .hash files.
+func SourceHash(code string) string {
+	h := sha1.New()
+	h.Write([]byte(code))
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// ReadHashFile returns the source hash and share key stored at path.
+func ReadHashFile(path string) (string, string, error) {
+	dat, err := os.ReadFile(path)
+	if err != nil {
+		return "", "", err
+	}
+	lines := strings.Split(string(dat), "\n")
+	if len(lines) < 2 {
+		return "", "", fmt.Errorf("%s: malformed hash file", path)
+	}
+	return lines[0], lines[1], nil
+}
+
+// WriteHashFile records the source hash and share key at path.
+func WriteHashFile(path, hash, key string) error {
+	data := fmt.Sprintf("%s\n%s\n", hash, key)
+	return os.WriteFile(path, []byte(data), 0644)
+}
+
+// Client shares sources on the Playground. The zero value is ready to use.
+type Client struct {
+	// Endpoint is the share URL; DefaultEndpoint if empty.
+	Endpoint string
+
+	// HTTPClient sends the requests; http.DefaultClient if nil.
+	HTTPClient *http.Client
+
+	// Interval is the minimum time between two share requests.
+	Interval time.Duration
+
+	last time.Time
+}
+
+// Share uploads the source and returns the key it was shared under.
+func (c *Client) Share(code string) (string, error) {
+	if wait := c.Interval - time.Since(c.last); !c.last.IsZero() && wait > 0 {
+		time.Sleep(wait)
+	}
+	c.last = time.Now()
+
+	endpoint := c.Endpoint
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Post(endpoint, "text/plain", strings.NewReader(code))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("share: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return strings.TrimSpace(string(body)), nil
+}
+
+// Update shares the source unless the hash file at path already records
+// it, and returns the current share key along with whether it changed.
+func (c *Client) Update(path, code string) (string, bool, error) {
+	hash := SourceHash(code)
+	if oldHash, key, err := ReadHashFile(path); err == nil && oldHash == hash {
+		return key, false, nil
+	}
+	key, err := c.Share(code)
+	if err != nil {
+		return "", false, err
+	}
+	if err := WriteHashFile(path, hash, key); err != nil {
+		return "", false, err
+	}
+	return key, true, nil
+}
@@ -0,0 +1,123 @@
+package playground
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newServer returns a fake share endpoint answering every request with key,
+// and a counter of the requests it received.
+func newServer(t *testing.T, key string) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+	var requests atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s; want POST", r.Method)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) == "" {
+			t.Error("empty request body")
+		}
+		io.WriteString(w, key)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func TestShare(t *testing.T) {
+	srv, _ := newServer(t, "abc123")
+	c := &Client{Endpoint: srv.URL}
+	key, err := c.Share("package main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "abc123" {
+		t.Errorf("key = %q; want abc123", key)
+	}
+}
+
+func TestShareError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "snippet too large", http.StatusRequestEntityTooLarge)
+	}))
+	defer srv.Close()
+	c := &Client{Endpoint: srv.URL}
+	if _, err := c.Share("package main"); err == nil {
+		t.Error("Share succeeded; want error")
+	}
+}
+
+func TestShareInterval(t *testing.T) {
+	srv, _ := newServer(t, "abc123")
+	c := &Client{Endpoint: srv.URL, Interval: 50 * time.Millisecond}
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := c.Share("package main"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("3 shares took %v; want at least 100ms", elapsed)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	srv, requests := newServer(t, "abc123")
+	c := &Client{Endpoint: srv.URL}
+	path := filepath.Join(t.TempDir(), "example.hash")
+
+	key, changed, err := c.Update(path, "package main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "abc123" || !changed {
+		t.Errorf("first Update = %q, %v; want abc123, true", key, changed)
+	}
+	hash, key, err := ReadHashFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash != SourceHash("package main") || key != "abc123" {
+		t.Errorf("hash file = %q, %q", hash, key)
+	}
+
+	// An unchanged source isn't shared again.
+	key, changed, err = c.Update(path, "package main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "abc123" || changed {
+		t.Errorf("second Update = %q, %v; want abc123, false", key, changed)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("%d share requests; want 1", n)
+	}
+
+	if _, changed, _ = c.Update(path, "package main\n"); !changed {
+		t.Error("Update of a changed source didn't share it")
+	}
+}
+
+func TestNoPlay(t *testing.T) {
+	var tests = []struct {
+		code string
+		want bool
+	}{
+		{"// noplay\n\npackage main\n", true},
+		{"// norun\n// noplay\n\npackage main\n", true},
+		{"package main\n\n// noplay here is just prose\n", false},
+		{"package main\n", false},
+	}
+
+	for _, tt := range tests {
+		if got := NoPlay(tt.code); got != tt.want {
+			t.Errorf("NoPlay(%q) = %v; want %v", tt.code, got, tt.want)
+		}
+	}
+}
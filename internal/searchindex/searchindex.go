@@ -0,0 +1,182 @@
+// Package searchindex extracts, from the sources of each example, what a
+// client-side search needs: a title, a plain-text excerpt of the comments
+// and the standard library packages the example imports.
+package searchindex
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gobyexample/internal/site"
+	"gobyexample/internal/stripcomments"
+)
+
+// FileName is the name of the index written into the generated site.
+const FileName = "search-index.json"
+
+// MaxExcerpt bounds the length, in runes, of an entry's excerpt.
+var MaxExcerpt = 300
+
+// Entry describes a single example in the search index.
+type Entry struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Title   string   `json:"title"`
+	Excerpt string   `json:"excerpt"`
+	Imports []string `json:"imports"`
+}
+
+// markerPat matches the marker comments meant for the tooling, such as
+// `// norun`, once the comment markers are stripped.
+var markerPat = regexp.MustCompile(`^(norun|noplay)$`)
+
+// linkPat matches Markdown links, keeping their text.
+var linkPat = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+
+// plain turns a line of Markdown comment text into plain text.
+func plain(line string) string {
+	line = linkPat.ReplaceAllString(line, "$1")
+	line = strings.ReplaceAll(line, "`", "")
+	return strings.Join(strings.Fields(line), " ")
+}
+
+// commentLines returns the text of every comment in f, in source order,
+// without comment markers, directives or tooling markers.
+func commentLines(f *ast.File) []string {
+	var lines []string
+	for _, group := range f.Comments {
+		for _, line := range strings.Split(group.Text(), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || markerPat.MatchString(line) {
+				continue
+			}
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// Title returns the first non-empty comment line of f, as plain text.
+func Title(f *ast.File) string {
+	for _, line := range commentLines(f) {
+		if title := plain(line); title != "" {
+			return title
+		}
+	}
+	return ""
+}
+
+// Excerpt returns the comments of f as a single line of plain text,
+// truncated to MaxExcerpt runes.
+func Excerpt(f *ast.File) string {
+	return truncate(text(f), MaxExcerpt)
+}
+
+// text returns the comments of f as a single line of plain text.
+func text(f *ast.File) string {
+	var parts []string
+	for _, line := range commentLines(f) {
+		if line = plain(line); line != "" {
+			parts = append(parts, line)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n]) + "…"
+}
+
+// Imports returns the sorted standard library import paths of f. Paths
+// whose first element contains a dot, such as golang.org/x/sync/errgroup,
+// are left out.
+func Imports(f *ast.File) []string {
+	var paths []string
+	for _, spec := range f.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		first, _, _ := strings.Cut(path, "/")
+		if strings.Contains(first, ".") {
+			continue
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Build returns the index entries of the examples of the repository at
+// root, in the order of its manifest. The title comes from the example's
+// main source file; the excerpt and imports cover all of its sources.
+func Build(root string, manifest *site.Manifest) ([]*Entry, error) {
+	var entries []*Entry
+	for _, e := range manifest.Entries() {
+		entry, err := build(filepath.Join(root, "examples", e.ID), e)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func build(dir string, e *site.Entry) (*Entry, error) {
+	sources, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	// The main source file comes first, so that it provides the title and
+	// the start of the excerpt.
+	main := filepath.Join(dir, e.ID+".go")
+	sort.SliceStable(sources, func(i, j int) bool {
+		return sources[i] == main && sources[j] != main
+	})
+
+	entry := &Entry{ID: e.ID, Name: e.Name, Imports: []string{}}
+	fset := token.NewFileSet()
+	var texts []string
+	seen := make(map[string]bool)
+	for _, source := range sources {
+		if filepath.Base(source) == stripcomments.OutputName {
+			continue
+		}
+		src, err := os.ReadFile(source)
+		if err != nil {
+			return nil, err
+		}
+		f, err := parser.ParseFile(fset, source, src, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		if entry.Title == "" {
+			entry.Title = Title(f)
+		}
+		if t := text(f); t != "" {
+			texts = append(texts, t)
+		}
+		for _, path := range Imports(f) {
+			if !seen[path] {
+				seen[path] = true
+				entry.Imports = append(entry.Imports, path)
+			}
+		}
+	}
+	sort.Strings(entry.Imports)
+	// Truncate once, after joining, so that a long main source doesn't
+	// leave an ellipsis in the middle of the excerpt.
+	entry.Excerpt = truncate(strings.Join(texts, " "), MaxExcerpt)
+	return entry, nil
+}
@@ -0,0 +1,138 @@
+package searchindex
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"gobyexample/internal/site"
+)
+
+func parse(t *testing.T, src string) *ast.File {
+	t.Helper()
+	f, err := parser.ParseFile(token.NewFileSet(), "x.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+const src = `// norun
+
+//go:build linux
+
+// Examples can link to the [Go spec](https://go.dev/ref/spec)
+// and quote ` + "`code`" + `.
+
+package main
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	_ "time/tzdata"
+
+	"golang.org/x/sync/errgroup"
+)
+
+import . "strings"
+
+// Indented   comments
+//	keep only their text.
+func main() {}
+`
+
+func TestImports(t *testing.T) {
+	got := Imports(parse(t, src))
+	want := []string{"fmt", "html/template", "strings", "time/tzdata"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Imports = %q; want %q", got, want)
+	}
+}
+
+func TestTitle(t *testing.T) {
+	var tests = []struct {
+		src, title string
+	}{
+		{src, "Examples can link to the Go spec"},
+		{"//\n// First line.\n// Second line.\npackage main\n", "First line."},
+		{"package main\n\nfunc main() {}\n", ""},
+	}
+
+	for _, tt := range tests {
+		if title := Title(parse(t, tt.src)); title != tt.title {
+			t.Errorf("Title = %q; want %q", title, tt.title)
+		}
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	got := Excerpt(parse(t, src))
+	want := "Examples can link to the Go spec and quote code. Indented comments keep only their text."
+	if got != want {
+		t.Errorf("Excerpt = %q; want %q", got, want)
+	}
+
+	defer func(n int) { MaxExcerpt = n }(MaxExcerpt)
+	MaxExcerpt = 8
+	if got := Excerpt(parse(t, src)); got != "Examples…" {
+		t.Errorf("truncated Excerpt = %q", got)
+	}
+}
+
+func TestBuildExcerpt(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"multi.go": "// First file.\npackage main\n",
+		"other.go": "// Second file.\npackage main\n",
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	defer func(n int) { MaxExcerpt = n }(MaxExcerpt)
+	MaxExcerpt = 20
+	e, err := build(dir, &site.Entry{ID: "multi", Name: "Multi"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "First file. Second f…"; e.Excerpt != want {
+		t.Errorf("Excerpt = %q; want %q", e.Excerpt, want)
+	}
+
+	MaxExcerpt = 8
+	if e, err = build(dir, &site.Entry{ID: "multi", Name: "Multi"}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "First fi…"; e.Excerpt != want {
+		t.Errorf("Excerpt = %q; want %q", e.Excerpt, want)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	root := filepath.Join("..", "..")
+	manifest, err := site.ReadManifest(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := Build(root, manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != len(manifest.Entries()) {
+		t.Fatalf("got %d entries for %d examples", len(entries), len(manifest.Entries()))
+	}
+	for _, e := range entries {
+		if e.Title == "" || e.Excerpt == "" {
+			t.Errorf("%s: empty title or excerpt", e.ID)
+		}
+	}
+	if e := entries[0]; e.ID != "hello-world" || !reflect.DeepEqual(e.Imports, []string{"fmt"}) {
+		t.Errorf("first entry = %+v; want hello-world importing fmt", e)
+	}
+}
@@ -0,0 +1,148 @@
+package site
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ManifestFile is the name, relative to the repository root, of the
+// ordered list of examples the site is generated from.
+const ManifestFile = "examples.txt"
+
+// Entry is a single example listed in the manifest, linked to the entries
+// around it in reading order.
+type Entry struct {
+	ID, Name   string
+	Prev, Next *Entry
+}
+
+// Manifest is the ordered list of examples, grouped into the sections
+// shown on the index page.
+type Manifest struct {
+	Sections [][]*Entry
+
+	// Warnings lists problems that don't prevent building the site, such
+	// as examples found on disk but missing from the manifest.
+	Warnings []string
+}
+
+// Entries returns every entry of the manifest in reading order.
+func (m *Manifest) Entries() []*Entry {
+	var entries []*Entry
+	for _, section := range m.Sections {
+		entries = append(entries, section...)
+	}
+	return entries
+}
+
+// ParseManifest parses the lines of a manifest. Each line holds the English
+// name of an example, optionally followed by "->" and its translated name.
+// Blank lines separate sections, and lines starting with "#" are ignored.
+func ParseManifest(lines []string) *Manifest {
+	m := &Manifest{}
+	var section []*Entry
+	flush := func() {
+		if len(section) > 0 {
+			m.Sections = append(m.Sections, section)
+			section = nil
+		}
+	}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			flush()
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, display, _ := strings.Cut(line, "->")
+		entry := &Entry{ID: ExampleID(name), Name: name}
+		if display = strings.TrimSpace(display); display != "" {
+			entry.Name = display
+		}
+		section = append(section, entry)
+	}
+	flush()
+	m.link()
+	return m
+}
+
+// ReadManifest reads the manifest of the repository at root and checks it
+// against the example directories under root/examples. Listing an example
+// that doesn't exist is an error; examples that aren't listed are appended,
+// sorted, as a last section, with a warning for each.
+func ReadManifest(root string) (*Manifest, error) {
+	f, err := os.Open(filepath.Join(root, ManifestFile))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	m := ParseManifest(lines)
+
+	dirs, err := filepath.Glob(filepath.Join(root, "examples", "*"))
+	if err != nil {
+		return nil, err
+	}
+	onDisk := make(map[string]bool)
+	for _, dir := range dirs {
+		if fi, err := os.Stat(dir); err == nil && fi.IsDir() {
+			onDisk[filepath.Base(dir)] = true
+		}
+	}
+
+	listed := make(map[string]bool)
+	for _, entry := range m.Entries() {
+		if listed[entry.ID] {
+			return nil, fmt.Errorf("%s: %s is listed more than once", ManifestFile, entry.ID)
+		}
+		if !onDisk[entry.ID] {
+			return nil, fmt.Errorf("%s: %s is listed, but examples/%s does not exist", ManifestFile, entry.ID, entry.ID)
+		}
+		listed[entry.ID] = true
+	}
+
+	var unlisted []string
+	for id := range onDisk {
+		if !listed[id] {
+			unlisted = append(unlisted, id)
+		}
+	}
+	sort.Strings(unlisted)
+	var section []*Entry
+	for _, id := range unlisted {
+		m.Warnings = append(m.Warnings, fmt.Sprintf("examples/%s is not listed in %s; appending it", id, ManifestFile))
+		section = append(section, &Entry{ID: id, Name: id})
+	}
+	if len(section) > 0 {
+		m.Sections = append(m.Sections, section)
+		m.link()
+	}
+	return m, nil
+}
+
+// link wires each entry to the previous and next ones, across sections.
+func (m *Manifest) link() {
+	entries := m.Entries()
+	for i, entry := range entries {
+		entry.Prev, entry.Next = nil, nil
+		if i > 0 {
+			entry.Prev = entries[i-1]
+		}
+		if i < len(entries)-1 {
+			entry.Next = entries[i+1]
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package site
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// ids returns the IDs of the entries of each section.
+func ids(m *Manifest) [][]string {
+	var sections [][]string
+	for _, section := range m.Sections {
+		var ids []string
+		for _, entry := range section {
+			ids = append(ids, entry.ID)
+		}
+		sections = append(sections, ids)
+	}
+	return sections
+}
+
+func TestParseManifest(t *testing.T) {
+	m := ParseManifest([]string{"# Comment", "Hello World", "  ", "Values->值", "If/Else->", ""})
+	want := [][]string{{"hello-world"}, {"values", "if-else"}}
+	if got := ids(m); !reflect.DeepEqual(got, want) {
+		t.Errorf("sections = %q; want %q", got, want)
+	}
+	var names []string
+	for _, entry := range m.Entries() {
+		names = append(names, entry.Name)
+	}
+	if want := []string{"Hello World", "值", "If/Else"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("names = %q; want %q", names, want)
+	}
+}
+
+func TestReadManifest(t *testing.T) {
+	m, err := ReadManifest(filepath.Join("testdata", "manifest"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{{"hello-world", "values"}, {"if-else"}, {"apple", "zebra"}}
+	if got := ids(m); !reflect.DeepEqual(got, want) {
+		t.Errorf("sections = %q; want %q", got, want)
+	}
+	if len(m.Warnings) != 2 || !strings.Contains(m.Warnings[0], "examples/apple") {
+		t.Errorf("Warnings = %q; want one for apple and zebra each", m.Warnings)
+	}
+
+	// Entries are linked across sections, including the appended one.
+	entries := m.Entries()
+	for i, entry := range entries {
+		var prev, next *Entry
+		if i > 0 {
+			prev = entries[i-1]
+		}
+		if i < len(entries)-1 {
+			next = entries[i+1]
+		}
+		if entry.Prev != prev || entry.Next != next {
+			t.Errorf("%s: Prev, Next = %v, %v; want %v, %v", entry.ID, entry.Prev, entry.Next, prev, next)
+		}
+	}
+}
+
+func TestReadManifestMissing(t *testing.T) {
+	_, err := ReadManifest(filepath.Join("testdata", "manifest-missing"))
+	if err == nil || !strings.Contains(err.Error(), "examples/hello-world does not exist") {
+		t.Errorf("ReadManifest error = %v; want hello-world reported missing", err)
+	}
+}
+
+// render executes the site template name on data.
+func render(t *testing.T, name string, data any) string {
+	t.Helper()
+	tmpl, err := Template(filepath.Join("..", "..", "templates"), name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		t.Fatal(err)
+	}
+	return out.String()
+}
+
+func TestRenderNavigation(t *testing.T) {
+	m, err := ReadManifest(filepath.Join("testdata", "manifest"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	index := render(t, "index", m)
+	if n := strings.Count(index, `<ul class="section">`); n != 3 {
+		t.Errorf("index has %d sections; want 3", n)
+	}
+	sections := strings.Split(index, `<ul class="section">`)[1:]
+	for i, want := range [][]string{{"hello-world", "values"}, {"if-else"}, {"apple", "zebra"}} {
+		for _, id := range want {
+			if i < len(sections) && !strings.Contains(sections[i], `href="`+id+`"`) {
+				t.Errorf("index section %d doesn't link to %s", i, id)
+			}
+		}
+	}
+
+	// The example template only needs the navigation fields here.
+	type page struct {
+		*Entry
+		Segs    [][]*Seg
+		URLHash string
+	}
+	var tests = []struct {
+		entry     *Entry
+		want, not []string
+	}{
+		{m.Sections[0][0], []string{`href="values" rel="next"`, `<a href="./">目录</a>`}, []string{`rel="prev"`}},
+		{m.Sections[0][1], []string{`href="hello-world" rel="prev"`, `href="if-else" rel="next"`}, nil},
+		{m.Sections[2][1], []string{`href="apple" rel="prev"`}, []string{`rel="next"`}},
+	}
+	for _, tt := range tests {
+		html := render(t, "example", page{Entry: tt.entry})
+		for _, s := range tt.want {
+			if !strings.Contains(html, s) {
+				t.Errorf("%s page is missing %s", tt.entry.ID, s)
+			}
+		}
+		for _, s := range tt.not {
+			if strings.Contains(html, s) {
+				t.Errorf("%s page unexpectedly has %s", tt.entry.ID, s)
+			}
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package site
+
+import (
+	"regexp"
+	"strings"
+)
+
+var dashPat = regexp.MustCompile("\\-+")
+
+// ExampleID turns an English example name, as listed in examples.txt, into
+// the name of its directory under examples/ and of its generated page.
+func ExampleID(name string) string {
+	id := strings.ToLower(name)
+	id = strings.Replace(id, " ", "-", -1)
+	id = strings.Replace(id, "/", "-", -1)
+	id = strings.Replace(id, "'", "", -1)
+	return dashPat.ReplaceAllString(id, "-")
+}
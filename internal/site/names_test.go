@@ -0,0 +1,21 @@
+package site
+
+import "testing"
+
+func TestExampleID(t *testing.T) {
+	var tests = []struct {
+		name, id string
+	}{
+		{"Hello World", "hello-world"},
+		{"If/Else", "if-else"},
+		{"Time Formatting / Parsing", "time-formatting-parsing"},
+		{"Exec'ing Processes", "execing-processes"},
+		{"HTTP Client", "http-client"},
+	}
+
+	for _, tt := range tests {
+		if id := ExampleID(tt.name); id != tt.id {
+			t.Errorf("ExampleID(%q) = %q; want %q", tt.name, id, tt.id)
+		}
+	}
+}
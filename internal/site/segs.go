@@ -0,0 +1,79 @@
+// Package site holds the parts of the site generator in tools/generate.go
+// that don't depend on rendering, so that they can be tested on their own.
+package site
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var docsPat = regexp.MustCompile("^\\s*(\\/\\/|#)\\s")
+
+// markerPat matches marker comments such as `// norun` or `// noplay`,
+// which are meant for the tooling and are left out of the rendered page.
+var markerPat = regexp.MustCompile("^// (norun|noplay)\\s*$")
+
+// Seg is a segment of an example
+type Seg struct {
+	Docs, DocsRendered              string
+	Code, CodeRendered, CodeForJs   string
+	CodeEmpty, CodeLeading, CodeRun bool
+}
+
+func debug(msg string) {
+	if os.Getenv("DEBUG") == "1" {
+		fmt.Fprintln(os.Stderr, msg)
+	}
+}
+
+// ParseSegs splits the lines of an example source into segments, each
+// pairing a block of comments with the code that follows it. A blank line
+// ends the current segment.
+func ParseSegs(lines []string) []*Seg {
+	segs := []*Seg{}
+	lastSeen := ""
+	for _, line := range lines {
+		if markerPat.MatchString(line) {
+			continue
+		}
+		if line == "" {
+			lastSeen = ""
+			continue
+		}
+		matchDocs := docsPat.MatchString(line)
+		matchCode := !matchDocs
+		newDocs := (lastSeen == "") || ((lastSeen != "docs") && (segs[len(segs)-1].Docs != ""))
+		newCode := (lastSeen == "") || ((lastSeen != "code") && (segs[len(segs)-1].Code != ""))
+		if newDocs || newCode {
+			debug("NEWSEG")
+		}
+		if matchDocs {
+			trimmed := docsPat.ReplaceAllString(line, "")
+			if newDocs {
+				newSeg := Seg{Docs: trimmed, Code: ""}
+				segs = append(segs, &newSeg)
+			} else {
+				segs[len(segs)-1].Docs = segs[len(segs)-1].Docs + "\n" + trimmed
+			}
+			debug("DOCS: " + line)
+			lastSeen = "docs"
+		} else if matchCode {
+			if newCode {
+				newSeg := Seg{Docs: "", Code: line}
+				segs = append(segs, &newSeg)
+			} else {
+				segs[len(segs)-1].Code = segs[len(segs)-1].Code + "\n" + line
+			}
+			debug("CODE: " + line)
+			lastSeen = "code"
+		}
+	}
+	for i, seg := range segs {
+		seg.CodeEmpty = (seg.Code == "")
+		seg.CodeLeading = (i < (len(segs) - 1))
+		seg.CodeRun = strings.Contains(seg.Code, "package main")
+	}
+	return segs
+}
@@ -0,0 +1,55 @@
+package site
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func readFixture(t *testing.T, name string) []string {
+	t.Helper()
+	dat, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(string(dat), "\n")
+}
+
+func TestParseSegs(t *testing.T) {
+	var tests = []struct {
+		fixture string
+		want    []Seg
+	}{
+		{"docs-and-code.go", []Seg{
+			{Docs: "Leading docs describe the\nwhole example.", CodeEmpty: true, CodeLeading: true},
+			{Code: "package main", CodeLeading: true, CodeRun: true},
+			{Code: `import "fmt"`, CodeLeading: true},
+			{Docs: "Docs for the following code.", Code: "\nfunc main() {\n\tfmt.Println(\"hi\")", CodeLeading: true},
+			{Docs: "A comment inside a block\nstarts a new segment.", Code: "\n\tfmt.Println(\"bye\")\n}"},
+		}},
+		{"marker.go", []Seg{
+			{Docs: "Docs after the marker.", Code: "\npackage main", CodeLeading: true, CodeRun: true},
+			{Code: "func main() {}"},
+		}},
+		{"output.sh", []Seg{
+			{Docs: "Run the program.", Code: "\n$ go run output.go\nhi\nbye", CodeLeading: true},
+			{Docs: "Done.", CodeEmpty: true},
+		}},
+	}
+
+	// When code follows docs within a segment, the splitter keeps the line
+	// break between them at the start of Code.
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			var got []Seg
+			for _, seg := range ParseSegs(readFixture(t, tt.fixture)) {
+				got = append(got, *seg)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v\nwant %#v", got, tt.want)
+			}
+		})
+	}
+}
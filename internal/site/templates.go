@@ -0,0 +1,23 @@
+package site
+
+import (
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+// Template parses the page template dir/name.tmpl along with the footer it
+// includes.
+func Template(dir, name string) (*template.Template, error) {
+	t := template.New(name)
+	for _, file := range []string{"footer.tmpl", name + ".tmpl"} {
+		src, err := os.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := t.Parse(string(src)); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
@@ -0,0 +1,15 @@
+// Leading docs describe the
+// whole example.
+
+package main
+
+import "fmt"
+
+// Docs for the following code.
+func main() {
+	fmt.Println("hi")
+
+	// A comment inside a block
+	// starts a new segment.
+	fmt.Println("bye")
+}
@@ -0,0 +1,7 @@
+// norun
+// noplay
+
+// Docs after the marker.
+package main
+
+func main() {}
@@ -0,0 +1,6 @@
+# Run the program.
+$ go run output.go
+hi
+bye
+
+# Done.
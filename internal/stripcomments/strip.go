@@ -0,0 +1,95 @@
+// Package stripcomments removes the teaching comments from example sources,
+// leaving code that can be read, or copied and pasted, on its own.
+package stripcomments
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"regexp"
+)
+
+// OutputName is the name of the stripped copy written next to an example's
+// source. The site generator and the other tools leave it alone.
+const OutputName = "code-only.go"
+
+// directivePat matches comments the go tool and compiler act on, such as
+// `//go:embed`, `//go:generate` or `//line`, which must survive stripping.
+// Like in go/ast, directives have no space after the slashes.
+var directivePat = regexp.MustCompile(`^//(line |extern |export |[a-z0-9]+:[a-z0-9])`)
+
+// Strip returns src with every comment removed, except for directives, and
+// formatted with gofmt.
+//
+// The result sits in the same directory, and package, as src, so it is
+// given a `//go:build ignore` constraint, combined with the one of src, if
+// any, to keep it out of the package. It can still be built or run on its
+// own by naming it on the command line, as in `go run code-only.go`.
+func Strip(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var expr constraint.Expr = &constraint.TagExpr{Tag: "ignore"}
+	var kept []*ast.CommentGroup
+	for _, group := range f.Comments {
+		var list []*ast.Comment
+		for _, c := range group.List {
+			switch {
+			case constraint.IsGoBuild(c.Text):
+				x, err := constraint.Parse(c.Text)
+				if err != nil {
+					return nil, err
+				}
+				expr = &constraint.AndExpr{X: expr, Y: x}
+			case constraint.IsPlusBuild(c.Text):
+				// Superseded by the //go:build line written below.
+			case directivePat.MatchString(c.Text):
+				list = append(list, c)
+			}
+		}
+		if len(list) > 0 {
+			kept = append(kept, &ast.CommentGroup{List: list})
+		}
+	}
+	f.Comments = kept
+	clearDocs(f)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "//go:build %s\n\n", expr)
+	if err := printer.Fprint(&buf, fset, f); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// clearDocs drops the references nodes keep to their doc and line comments,
+// so that only the comments left in the file's list get printed.
+func clearDocs(f *ast.File) {
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.File:
+			n.Doc = nil
+		case *ast.GenDecl:
+			n.Doc = nil
+		case *ast.FuncDecl:
+			n.Doc = nil
+		case *ast.Field:
+			n.Doc, n.Comment = nil, nil
+		case *ast.ImportSpec:
+			n.Doc, n.Comment = nil, nil
+		case *ast.ValueSpec:
+			n.Doc, n.Comment = nil, nil
+		case *ast.TypeSpec:
+			n.Doc, n.Comment = nil, nil
+		}
+		return true
+	})
+}
@@ -0,0 +1,43 @@
+package stripcomments
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestStrip(t *testing.T) {
+	src, err := os.ReadFile(filepath.Join("testdata", "mixed.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile(filepath.Join("testdata", "mixed.golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Strip(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("Strip =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestStripWithoutConstraint(t *testing.T) {
+	got, err := Strip([]byte("// 说明。\npackage main\n\nfunc main() {} // 结束\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "//go:build ignore\n\npackage main\n\nfunc main() {}\n"
+	if string(got) != want {
+		t.Errorf("Strip = %q; want %q", got, want)
+	}
+}
+
+func TestStripInvalid(t *testing.T) {
+	if _, err := Strip([]byte("package main\n\nfunc main() {")); err == nil || !strings.Contains(err.Error(), "expected") {
+		t.Errorf("Strip(invalid) error = %v; want a syntax error", err)
+	}
+}
@@ -0,0 +1,35 @@
+// norun
+
+//go:build !windows
+// +build !windows
+
+// 这个例子的说明。
+
+package main
+
+import (
+	"embed"
+	"fmt" // 行尾注释
+)
+
+// 嵌入一个文件。
+//
+//go:embed mixed.go
+var src embed.FS
+
+/* 块注释 */
+type point struct {
+	// 字段的注释。
+	X, Y int // 坐标
+}
+
+//go:generate echo generated
+
+// main 的文档注释。
+func main() {
+
+	// 这是一段说明。
+	p := point{1, 2} /* 行内注释 */
+	fmt.Println(p, src)
+	//lint:ignore U1000 directives without a space survive
+}
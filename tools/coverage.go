@@ -0,0 +1,66 @@
+// Reports how completely this translation covers the upstream English
+// examples listed in upstream-index.txt: which of them exist under
+// examples/, which are missing, and which local examples have no upstream
+// counterpart. It also lists examples whose comments look untranslated.
+// Exits non-zero if the coverage is below -threshold percent.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"gobyexample/internal/coverage"
+)
+
+func main() {
+	index := flag.String("index", "upstream-index.txt", "list of upstream example names")
+	threshold := flag.Float64("threshold", 90, "minimum percentage of upstream examples that must be present")
+	minTranslated := flag.Float64("min-translated", 0.5, "minimum share of comment lines containing Chinese")
+	flag.Parse()
+
+	upstream, err := coverage.ReadIndex(*index)
+	if err != nil {
+		log.Fatal(err)
+	}
+	local, err := coverage.LocalExamples(".")
+	if err != nil {
+		log.Fatal(err)
+	}
+	report := coverage.Compare(upstream, local)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "upstream\t%d\n", len(report.Upstream))
+	fmt.Fprintf(w, "present\t%d\t(%.1f%%)\n", len(report.Present), report.Percent())
+	fmt.Fprintf(w, "missing\t%d\t%s\n", len(report.Missing), strings.Join(report.Missing, " "))
+	fmt.Fprintf(w, "extra\t%d\t%s\n", len(report.Extra), strings.Join(report.Extra, " "))
+	w.Flush()
+
+	var untranslated []string
+	for _, id := range local {
+		cjk, total, err := coverage.TranslatedDir(filepath.Join("examples", id))
+		if err != nil {
+			log.Fatal(err)
+		}
+		if total > 0 && float64(cjk)/float64(total) < *minTranslated {
+			untranslated = append(untranslated, fmt.Sprintf("%s\t%d/%d\n", id, cjk, total))
+		}
+	}
+	if len(untranslated) > 0 {
+		fmt.Println()
+		fmt.Println("possibly untranslated (comment lines with Chinese / all comment lines):")
+		for _, line := range untranslated {
+			fmt.Fprint(w, line)
+		}
+		w.Flush()
+	}
+
+	if report.Percent() < *threshold {
+		fmt.Fprintf(os.Stderr, "coverage %.1f%% is below the %.1f%% threshold\n", report.Percent(), *threshold)
+		os.Exit(1)
+	}
+}
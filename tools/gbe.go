@@ -0,0 +1,119 @@
+// A runner for working on a single example:
+//
+//	tools/gbe list           lists the examples
+//	tools/gbe run <name>     runs an example
+//	tools/gbe test <name>    compares an example's output with its golden file
+//	tools/gbe bless <name>   records an example's output as its golden file
+//
+// Names may be abbreviated, as long as they only match a single example.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"gobyexample/internal/harness"
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: tools/gbe list | run <name> | test <name> | bless <name>")
+	os.Exit(2)
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "gbe:", err)
+	os.Exit(1)
+}
+
+// colorize highlights removed and added lines of a harness.Diff, unless the
+// output is not a terminal or NO_COLOR is set.
+func colorize(diff string) string {
+	fi, err := os.Stdout.Stat()
+	if err != nil || fi.Mode()&os.ModeCharDevice == 0 || os.Getenv("NO_COLOR") != "" {
+		return diff
+	}
+	lines := strings.SplitAfter(diff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "-"):
+			lines[i] = "\x1b[31m" + strings.TrimSuffix(line, "\n") + "\x1b[0m\n"
+		case strings.HasPrefix(line, "+"):
+			lines[i] = "\x1b[32m" + strings.TrimSuffix(line, "\n") + "\x1b[0m\n"
+		}
+	}
+	return strings.Join(lines, "")
+}
+
+func main() {
+	root := flag.String("root", ".", "repository root")
+	flag.Usage = usage
+	flag.Parse()
+	args := flag.Args()
+	if len(args) == 0 {
+		usage()
+	}
+
+	examples, err := harness.Examples(*root)
+	if err != nil {
+		fatal(err)
+	}
+	if args[0] == "list" {
+		for _, e := range examples {
+			if e.NoRun {
+				fmt.Println(e.Name, "(norun)")
+			} else {
+				fmt.Println(e.Name)
+			}
+		}
+		return
+	}
+	if len(args) != 2 {
+		usage()
+	}
+	e, err := harness.Resolve(examples, args[1])
+	var amb *harness.AmbiguousError
+	if errors.As(err, &amb) {
+		fmt.Fprintf(os.Stderr, "gbe: %q matches several examples:\n", amb.Name)
+		for _, name := range amb.Candidates {
+			fmt.Fprintln(os.Stderr, "  "+name)
+		}
+		os.Exit(1)
+	} else if err != nil {
+		fatal(err)
+	}
+
+	switch args[0] {
+	case "run":
+		cmd := e.Command(context.Background())
+		cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+		if err := cmd.Run(); err != nil {
+			fatal(err)
+		}
+	case "test":
+		want, err := e.Golden()
+		if err != nil {
+			fatal(err)
+		}
+		got, err := e.Run()
+		if err != nil {
+			fatal(err)
+		}
+		if got != want {
+			fmt.Printf("%s: output mismatch (-want +got):\n", e.Name)
+			fmt.Print(colorize(harness.Diff(want, got)))
+			os.Exit(1)
+		}
+		fmt.Println(e.Name + ": ok")
+	case "bless":
+		if _, err := e.Bless(); err != nil {
+			fatal(err)
+		}
+		fmt.Printf("%s: wrote %s\n", e.Name, harness.GoldenFile)
+	default:
+		usage()
+	}
+}
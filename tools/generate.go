@@ -2,16 +2,13 @@ package main
 
 import (
 	"bytes"
-	"crypto/sha1"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strings"
-	"text/template"
 
 	"github.com/alecthomas/chroma"
 	"github.com/alecthomas/chroma/formatters/html"
@@ -19,6 +16,10 @@ import (
 	"github.com/alecthomas/chroma/styles"
 
 	"github.com/russross/blackfriday/v2"
+
+	"gobyexample/internal/playground"
+	"gobyexample/internal/site"
+	"gobyexample/internal/stripcomments"
 )
 
 // siteDir is the target directory into which the HTML gets generated. Its
@@ -67,13 +68,6 @@ func pipe(bin string, arg []string, src string) []byte {
 	return bytes
 }
 
-func sha1Sum(s string) string {
-	h := sha1.New()
-	h.Write([]byte(s))
-	b := h.Sum(nil)
-	return fmt.Sprintf("%x", b)
-}
-
 func mustReadFile(path string) string {
 	bytes, err := os.ReadFile(path)
 	check(err)
@@ -104,53 +98,28 @@ func whichLexer(path string) string {
 	panic("No lexer for " + path)
 }
 
-func debug(msg string) {
-	if os.Getenv("DEBUG") == "1" {
-		fmt.Fprintln(os.Stderr, msg)
-	}
-}
-
-var docsPat = regexp.MustCompile("^\\s*(\\/\\/|#)\\s")
-var dashPat = regexp.MustCompile("\\-+")
-
-// Seg is a segment of an example
-type Seg struct {
-	Docs, DocsRendered              string
-	Code, CodeRendered, CodeForJs   string
-	CodeEmpty, CodeLeading, CodeRun bool
-}
-
 // Example is info extracted from an example file
 type Example struct {
-	ID, Name                    string
+	*site.Entry
 	GoCode, GoCodeHash, URLHash string
-	Segs                        [][]*Seg
-	PrevExample                 *Example
-	NextExample                 *Example
+	Segs                        [][]*site.Seg
 }
 
-func parseHashFile(sourcePath string) (string, string) {
-	lines := readLines(sourcePath)
-	return lines[0], lines[1]
-}
+// playClient shares changed examples on the Go Playground.
+var playClient = &playground.Client{}
 
 func resetURLHashFile(codehash, code, sourcePath string) string {
 	if verbose() {
 		fmt.Println("  Sending request to play.golang.org")
 	}
-	payload := strings.NewReader(code)
-	resp, err := http.Post("https://play.golang.org/share", "text/plain", payload)
+	urlkey, err := playClient.Share(code)
 	check(err)
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
+	err = playground.WriteHashFile(sourcePath, codehash, urlkey)
 	check(err)
-	urlkey := string(body)
-	data := fmt.Sprintf("%s\n%s\n", codehash, urlkey)
-	os.WriteFile(sourcePath, []byte(data), 0644)
 	return urlkey
 }
 
-func parseSegs(sourcePath string) ([]*Seg, string) {
+func parseSegs(sourcePath string) ([]*site.Seg, string) {
 	var (
 		lines  []string
 		source []string
@@ -161,47 +130,7 @@ func parseSegs(sourcePath string) ([]*Seg, string) {
 		source = append(source, line)
 	}
 	filecontent := strings.Join(source, "\n")
-	segs := []*Seg{}
-	lastSeen := ""
-	for _, line := range lines {
-		if line == "" {
-			lastSeen = ""
-			continue
-		}
-		matchDocs := docsPat.MatchString(line)
-		matchCode := !matchDocs
-		newDocs := (lastSeen == "") || ((lastSeen != "docs") && (segs[len(segs)-1].Docs != ""))
-		newCode := (lastSeen == "") || ((lastSeen != "code") && (segs[len(segs)-1].Code != ""))
-		if newDocs || newCode {
-			debug("NEWSEG")
-		}
-		if matchDocs {
-			trimmed := docsPat.ReplaceAllString(line, "")
-			if newDocs {
-				newSeg := Seg{Docs: trimmed, Code: ""}
-				segs = append(segs, &newSeg)
-			} else {
-				segs[len(segs)-1].Docs = segs[len(segs)-1].Docs + "\n" + trimmed
-			}
-			debug("DOCS: " + line)
-			lastSeen = "docs"
-		} else if matchCode {
-			if newCode {
-				newSeg := Seg{Docs: "", Code: line}
-				segs = append(segs, &newSeg)
-			} else {
-				segs[len(segs)-1].Code = segs[len(segs)-1].Code + "\n" + line
-			}
-			debug("CODE: " + line)
-			lastSeen = "code"
-		}
-	}
-	for i, seg := range segs {
-		seg.CodeEmpty = (seg.Code == "")
-		seg.CodeLeading = (i < (len(segs) - 1))
-		seg.CodeRun = strings.Contains(seg.Code, "package main")
-	}
-	return segs, filecontent
+	return site.ParseSegs(lines), filecontent
 }
 
 func chromaFormat(code, filePath string) string {
@@ -230,7 +159,7 @@ func chromaFormat(code, filePath string) string {
 	return buf.String()
 }
 
-func parseAndRenderSegs(sourcePath string) ([]*Seg, string) {
+func parseAndRenderSegs(sourcePath string) ([]*site.Seg, string) {
 	segs, filecontent := parseSegs(sourcePath)
 	lexer := whichLexer(sourcePath)
 	for _, seg := range segs {
@@ -253,38 +182,37 @@ func parseAndRenderSegs(sourcePath string) ([]*Seg, string) {
 	return segs, filecontent
 }
 
-func parseExamples() []*Example {
-	var exampleNames []string
-	for _, line := range readLines("examples.txt") {
-		if line != "" && !strings.HasPrefix(line, "#") {
-			exampleNames = append(exampleNames, line)
-		}
+// generatedPat matches the comment marking generated Go sources, such as
+// the output of `go generate` committed next to an example.
+var generatedPat = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+
+func parseExamples(manifest *site.Manifest) []*Example {
+	for _, warning := range manifest.Warnings {
+		fmt.Fprintln(os.Stderr, "warning:", warning)
 	}
+	entries := manifest.Entries()
 	examples := make([]*Example, 0)
-	for i, exampleName := range exampleNames {
+	for i, entry := range entries {
 		if verbose() {
-			fmt.Printf("Processing %s [%d/%d]\n", exampleName, i+1, len(exampleNames))
-		}
-		exampleNameDisplay := exampleName
-		names := strings.Split(exampleName, "->")
-		exampleName = names[0]
-		exampleNameDisplay = names[0]
-		if len(names) > 1 && strings.Trim(names[1], " ") != "" {
-			exampleNameDisplay = names[1]
+			fmt.Printf("Processing %s [%d/%d]\n", entry.ID, i+1, len(entries))
 		}
-		example := Example{Name: exampleNameDisplay}
-		exampleID := strings.ToLower(exampleName)
-		exampleID = strings.Replace(exampleID, " ", "-", -1)
-		exampleID = strings.Replace(exampleID, "/", "-", -1)
-		exampleID = strings.Replace(exampleID, "'", "", -1)
-		exampleID = dashPat.ReplaceAllString(exampleID, "-")
-		example.ID = exampleID
-		example.Segs = make([][]*Seg, 0)
-		sourcePaths := mustGlob("examples/" + exampleID + "/*")
+		example := Example{Entry: entry}
+		example.Segs = make([][]*site.Seg, 0)
+		sourcePaths := mustGlob("examples/" + example.ID + "/*")
 		for _, sourcePath := range sourcePaths {
 			if strings.HasSuffix(sourcePath, ".hash") {
-				example.GoCodeHash, example.URLHash = parseHashFile(sourcePath)
-			} else {
+				var err error
+				example.GoCodeHash, example.URLHash, err = playground.ReadHashFile(sourcePath)
+				check(err)
+			} else if filepath.Base(sourcePath) == stripcomments.OutputName {
+				// Stripped copies written by tools/stripcomments aren't
+				// part of the example.
+				continue
+			} else if strings.HasSuffix(sourcePath, ".go") && isGenerated(sourcePath) {
+				// Generated sources are tool output rather than part of
+				// the lesson, and would otherwise be rendered first.
+				continue
+			} else if strings.HasSuffix(sourcePath, ".go") || strings.HasSuffix(sourcePath, ".sh") {
 				sourceSegs, filecontents := parseAndRenderSegs(sourcePath)
 				if filecontents != "" {
 					example.GoCode = filecontents
@@ -292,35 +220,33 @@ func parseExamples() []*Example {
 				example.Segs = append(example.Segs, sourceSegs)
 			}
 		}
-		newCodeHash := sha1Sum(example.GoCode)
-		if example.GoCodeHash != newCodeHash {
+		// Examples marked `// noplay` can't run on the Playground, so they
+		// get no share link.
+		newCodeHash := playground.SourceHash(example.GoCode)
+		if playground.NoPlay(example.GoCode) {
+			example.URLHash = ""
+		} else if example.GoCodeHash != newCodeHash {
 			example.URLHash = resetURLHashFile(newCodeHash, example.GoCode, "examples/"+example.ID+"/"+example.ID+".hash")
 		}
 		examples = append(examples, &example)
 	}
-	for i, example := range examples {
-		if i > 0 {
-			example.PrevExample = examples[i-1]
-		}
-		if i < (len(examples) - 1) {
-			example.NextExample = examples[i+1]
-		}
-	}
 	return examples
 }
 
-func renderIndex(examples []*Example) {
+// isGenerated reports whether the Go source at path is marked as generated.
+func isGenerated(path string) bool {
+	return generatedPat.MatchString(strings.Join(readLines(path), "\n"))
+}
+
+func renderIndex(manifest *site.Manifest) {
 	if verbose() {
 		fmt.Println("Rendering index")
 	}
-	indexTmpl := template.New("index")
-	_, err := indexTmpl.Parse(mustReadFile("templates/footer.tmpl"))
-	check(err)
-	_, err = indexTmpl.Parse(mustReadFile("templates/index.tmpl"))
+	indexTmpl, err := site.Template("templates", "index")
 	check(err)
 	indexF, err := os.Create(siteDir + "/index.html")
 	check(err)
-	err = indexTmpl.Execute(indexF, examples)
+	err = indexTmpl.Execute(indexF, manifest)
 	check(err)
 }
 
@@ -328,10 +254,7 @@ func renderExamples(examples []*Example) {
 	if verbose() {
 		fmt.Println("Rendering examples")
 	}
-	exampleTmpl := template.New("example")
-	_, err := exampleTmpl.Parse(mustReadFile("templates/footer.tmpl"))
-	check(err)
-	_, err = exampleTmpl.Parse(mustReadFile("templates/example.tmpl"))
+	exampleTmpl, err := site.Template("templates", "example")
 	check(err)
 	for _, example := range examples {
 		exampleF, err := os.Create(siteDir + "/" + example.ID + ".html")
@@ -352,8 +275,10 @@ func main() {
 	copyFile("templates/404.html", siteDir+"/404.html")
 	copyFile("templates/play.png", siteDir+"/play.png")
 	copyFile("templates/clipboard.png", siteDir+"/clipboard.png")
-	examples := parseExamples()
-	renderIndex(examples)
+	manifest, err := site.ReadManifest(".")
+	check(err)
+	examples := parseExamples(manifest)
+	renderIndex(manifest)
 	renderExamples(examples)
 }
 
@@ -0,0 +1,45 @@
+// Writes search-index.json into the generated site: for every example, in
+// the order of examples.txt, its name, title, a plain-text excerpt of its
+// comments and the standard library packages it imports, for client-side
+// search. The target directory defaults to ./public.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"gobyexample/internal/searchindex"
+	"gobyexample/internal/site"
+)
+
+func main() {
+	siteDir := "./public"
+	if len(os.Args) > 1 {
+		siteDir = os.Args[1]
+	}
+	manifest, err := site.ReadManifest(".")
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, warning := range manifest.Warnings {
+		fmt.Fprintln(os.Stderr, "warning:", warning)
+	}
+	entries, err := searchindex.Build(".", manifest)
+	if err != nil {
+		log.Fatal(err)
+	}
+	dat, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.MkdirAll(siteDir, 0755); err != nil {
+		log.Fatal(err)
+	}
+	path := filepath.Join(siteDir, searchindex.FileName)
+	if err := os.WriteFile(path, append(dat, '\n'), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
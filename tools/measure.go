@@ -7,6 +7,8 @@ import (
 	"regexp"
 	"strings"
 	"unicode/utf8"
+
+	"gobyexample/internal/stripcomments"
 )
 
 func check(err error) {
@@ -23,13 +25,28 @@ func readLines(path string) []string {
 
 var commentPat = regexp.MustCompile("\\s*\\/\\/")
 
+// generatedPat matches the comment marking generated Go sources, which are
+// committed as their tool wrote them.
+var generatedPat = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+
 func main() {
 	sourcePaths, err := filepath.Glob("./examples/*/*")
 	check(err)
 	foundLongFile := false
 	for _, sourcePath := range sourcePaths {
-		foundLongLine := false
+		// Only the sources rendered on the site are measured; golden output
+		// files and the like are left alone.
+		if !strings.HasSuffix(sourcePath, ".go") && !strings.HasSuffix(sourcePath, ".sh") {
+			continue
+		}
+		if filepath.Base(sourcePath) == stripcomments.OutputName {
+			continue
+		}
 		lines := readLines(sourcePath)
+		if generatedPat.MatchString(strings.Join(lines, "\n")) {
+			continue
+		}
+		foundLongLine := false
 		for i, line := range lines {
 			// Convert tabs to spaces before measuring, so we get an accurate measure
 			// of how long the output will end up being.
@@ -0,0 +1,68 @@
+// Shares every example on the Go Playground and records the share keys in
+// the examples' .hash files, which the generated pages link to.
+// Examples whose source is unchanged since they were last shared are
+// skipped, as are examples marked with a `// noplay` comment.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"gobyexample/internal/playground"
+	"gobyexample/internal/stripcomments"
+)
+
+func main() {
+	endpoint := flag.String("endpoint", playground.DefaultEndpoint, "Playground share URL")
+	interval := flag.Duration("interval", time.Second, "minimum time between two share requests")
+	flag.Parse()
+
+	client := &playground.Client{Endpoint: *endpoint, Interval: *interval}
+	dirs, err := filepath.Glob("examples/*")
+	if err != nil {
+		log.Fatal(err)
+	}
+	failed := false
+	for _, dir := range dirs {
+		id := filepath.Base(dir)
+		sources, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		sources = slices.DeleteFunc(sources, func(source string) bool {
+			return filepath.Base(source) == stripcomments.OutputName
+		})
+		if len(sources) == 0 {
+			continue
+		}
+		// Like tools/generate.go, take the last Go source of the example as
+		// the code that is shared.
+		code, err := os.ReadFile(sources[len(sources)-1])
+		if err != nil {
+			log.Fatal(err)
+		}
+		if playground.NoPlay(string(code)) {
+			fmt.Printf("%s: skipped (noplay)\n", id)
+			continue
+		}
+		hashPath := filepath.Join(dir, id+".hash")
+		key, changed, err := client.Update(hashPath, string(code))
+		switch {
+		case err != nil:
+			fmt.Fprintf(os.Stderr, "%s: %v\n", id, err)
+			failed = true
+		case changed:
+			fmt.Printf("%s: shared as %s\n", id, key)
+		default:
+			fmt.Printf("%s: unchanged\n", id)
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
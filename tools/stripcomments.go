@@ -0,0 +1,60 @@
+// Writes a copy of each example's source with the teaching comments
+// removed, as code-only.go next to it, for readers who want just the code.
+// With -check, also builds every copy to make sure stripping didn't break
+// it. The copies are ignored by git and by the other tools.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"gobyexample/internal/stripcomments"
+)
+
+func main() {
+	check := flag.Bool("check", false, "build every stripped copy")
+	flag.Parse()
+
+	dirs, err := filepath.Glob("examples/*")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	failed := false
+	for _, dir := range dirs {
+		id := filepath.Base(dir)
+		src, err := os.ReadFile(filepath.Join(dir, id+".go"))
+		if os.IsNotExist(err) {
+			// Examples made of tests, or several files, have no single
+			// source to strip.
+			continue
+		} else if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		out, err := stripcomments.Strip(src)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", id, err)
+			failed = true
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(dir, stripcomments.OutputName), out, 0644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if *check {
+			cmd := exec.Command("go", "build", "-o", os.DevNull, stripcomments.OutputName)
+			cmd.Dir = dir
+			if out, err := cmd.CombinedOutput(); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n%s", id, err, out)
+				failed = true
+			}
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}